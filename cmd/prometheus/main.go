@@ -59,6 +59,7 @@ import (
 	"github.com/prometheus/prometheus/pkg/relabel"
 	prom_runtime "github.com/prometheus/prometheus/pkg/runtime"
 	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/prometheus/prometheus/rules"
 	"github.com/prometheus/prometheus/scrape"
 	"github.com/prometheus/prometheus/storage"
@@ -106,25 +107,30 @@ func main() {
 	cfg := struct {
 		configFile string
 
-		localStoragePath    string
-		notifier            notifier.Options
-		notifierTimeout     model.Duration
-		forGracePeriod      model.Duration
-		outageTolerance     model.Duration
-		resendDelay         model.Duration
-		web                 web.Options
-		tsdb                tsdbOptions
-		lookbackDelta       model.Duration
-		webTimeout          model.Duration
-		queryTimeout        model.Duration
-		queryConcurrency    int
-		queryMaxSamples     int
-		RemoteFlushDeadline model.Duration
+		localStoragePath     string
+		notifier             notifier.Options
+		notifierTimeout      model.Duration
+		forGracePeriod       model.Duration
+		outageTolerance      model.Duration
+		resendDelay          model.Duration
+		web                  web.Options
+		tsdb                 tsdbOptions
+		lookbackDelta        model.Duration
+		webTimeout           model.Duration
+		queryTimeout         model.Duration
+		queryConcurrency     int
+		queryMaxSamples      int
+		queryMaxSampleBytes  int64
+		queryAggrConcurrency int
+		RemoteFlushDeadline  model.Duration
+		scrapeMaxConcurrent  int
 
 		prometheusURL   string
 		corsRegexString string
 
 		promlogConfig promlog.Config
+
+		featureList []string
 	}{
 		notifier: notifier.Options{
 			Registerer: prometheus.DefaultRegisterer,
@@ -181,6 +187,12 @@ func main() {
 	a.Flag("web.page-title", "Document title of Prometheus instance.").
 		Default("Prometheus Time Series Collection and Processing Server").StringVar(&cfg.web.PageTitle)
 
+	a.Flag("web.api-compression-min-size", "Minimum response size, in bytes, before the HTTP API gzip/deflate-compresses it. 0 compresses every response.").
+		Default("0").IntVar(&cfg.web.APIResponseCompressionMinSize)
+
+	a.Flag("web.api-compression-level", "Compression level to use for the HTTP API, from 1 (fastest) to 9 (best compression). 0 uses the default level.").
+		Default("0").IntVar(&cfg.web.APIResponseCompressionLevel)
+
 	a.Flag("web.cors.origin", `Regex for CORS origin. It is fully anchored. Example: 'https?://(domain1|domain2)\.com'`).
 		Default(".*").StringVar(&cfg.corsRegexString)
 
@@ -216,6 +228,12 @@ func main() {
 	a.Flag("storage.tsdb.wal-compression", "Compress the tsdb WAL.").
 		Default("true").BoolVar(&cfg.tsdb.WALCompression)
 
+	a.Flag("storage.tsdb.block-warmup-bandwidth", "[EXPERIMENTAL] Read through the index and chunks of the most recent blocks at roughly this rate after startup, to warm the page cache before the first queries arrive. 0 disables warm-up. Example: 50MB").
+		Default("0").BytesVar(&cfg.tsdb.BlockWarmupBandwidth)
+
+	a.Flag("storage.tsdb.head-chunk-write-queue-size", "[EXPERIMENTAL] Size of the write queue used by the head chunks mapper. Lower values bound a burst of series creation to fewer concurrently waiting writers sooner.").
+		Default("1000").IntVar(&cfg.tsdb.HeadChunkWriteQueueSize)
+
 	a.Flag("storage.remote.flush-deadline", "How long to wait flushing sample on shutdown or config reload.").
 		Default("1m").PlaceHolder("<duration>").SetValue(&cfg.RemoteFlushDeadline)
 
@@ -228,6 +246,9 @@ func main() {
 	a.Flag("storage.remote.read-max-bytes-in-frame", "Maximum number of bytes in a single frame for streaming remote read response types before marshalling. Note that client might have limit on frame size as well. 1MB as recommended by protobuf by default.").
 		Default("1048576").IntVar(&cfg.web.RemoteReadBytesInFrame)
 
+	a.Flag("storage.remote.read-max-bytes-in-response", "Maximum marshalled size of a single (non-streamed) remote read response. 0 means no limit. Protects against a query matching an unexpectedly large number of series from building an unbounded response in memory.").
+		Default("0").IntVar(&cfg.web.RemoteReadMaxBytesInResponse)
+
 	a.Flag("rules.alert.for-outage-tolerance", "Max time to tolerate prometheus outage for restoring \"for\" state of alert.").
 		Default("1h").SetValue(&cfg.outageTolerance)
 
@@ -252,9 +273,21 @@ func main() {
 	a.Flag("query.max-concurrency", "Maximum number of queries executed concurrently.").
 		Default("20").IntVar(&cfg.queryConcurrency)
 
+	a.Flag("scrape.max-concurrent-scrapes", "Maximum number of scrapes that can be in flight at once, across every scrape pool. 0 picks a default based on GOMAXPROCS.").
+		Default("0").IntVar(&cfg.scrapeMaxConcurrent)
+
 	a.Flag("query.max-samples", "Maximum number of samples a single query can load into memory. Note that queries will fail if they try to load more samples than this into memory, so this also limits the number of samples a query can return.").
 		Default("50000000").IntVar(&cfg.queryMaxSamples)
 
+	a.Flag("query.max-sample-bytes", "Maximum estimated memory, in bytes, that the samples held by a single query's intermediate matrices may occupy. This is a tighter proxy for actual memory use than query.max-samples, since it accounts for the size of each sample's label set rather than counting every sample the same. 0 disables the check.").
+		Default("0").Int64Var(&cfg.queryMaxSampleBytes)
+
+	a.Flag("query.aggregation-concurrency", "Number of goroutines used to evaluate a single grouping aggregation (sum, avg, topk, ...) in parallel. Values <= 1 evaluate aggregations on the query's own goroutine, as before.").
+		Default("1").IntVar(&cfg.queryAggrConcurrency)
+
+	a.Flag("enable-feature", "Comma separated feature names to enable. Valid options: 'promql-experimental-functions', 'promql-label-matching-grouping', 'promql-negative-offset'. See https://prometheus.io/docs/prometheus/latest/feature_flags/ for more details.").
+		Default("").StringsVar(&cfg.featureList)
+
 	promlogflag.AddFlags(a, &cfg.promlogConfig)
 
 	_, err := a.Parse(os.Args[1:])
@@ -266,6 +299,26 @@ func main() {
 
 	logger := promlog.New(&cfg.promlogConfig)
 
+	for _, f := range cfg.featureList {
+		for _, feature := range strings.Split(f, ",") {
+			switch feature {
+			case "promql-experimental-functions":
+				parser.EnableExperimentalFunctions = true
+				level.Info(logger).Log("msg", "Experimental PromQL functions enabled", "feature", feature)
+			case "promql-label-matching-grouping":
+				parser.EnableLabelMatchingGrouping = true
+				level.Info(logger).Log("msg", "PromQL label_matching() grouping enabled", "feature", feature)
+			case "promql-negative-offset":
+				parser.EnableNegativeOffset = true
+				level.Info(logger).Log("msg", "Negative offset for PromQL is enabled", "feature", feature)
+			case "":
+				continue
+			default:
+				level.Warn(logger).Log("msg", "Unknown option for --enable-feature", "option", feature)
+			}
+		}
+	}
+
 	cfg.web.ExternalURL, err = computeExternalURL(cfg.prometheusURL, cfg.web.ListenAddress)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "parse external URL %q", cfg.prometheusURL))
@@ -364,15 +417,17 @@ func main() {
 		ctxNotify, cancelNotify = context.WithCancel(context.Background())
 		discoveryManagerNotify  = discovery.NewManager(ctxNotify, log.With(logger, "component", "discovery manager notify"), discovery.Name("notify"))
 
-		scrapeManager = scrape.NewManager(log.With(logger, "component", "scrape manager"), fanoutStorage)
+		scrapeManager = scrape.NewManager(log.With(logger, "component", "scrape manager"), fanoutStorage, cfg.scrapeMaxConcurrent)
 
 		opts = promql.EngineOpts{
-			Logger:             log.With(logger, "component", "query engine"),
-			Reg:                prometheus.DefaultRegisterer,
-			MaxSamples:         cfg.queryMaxSamples,
-			Timeout:            time.Duration(cfg.queryTimeout),
-			ActiveQueryTracker: promql.NewActiveQueryTracker(cfg.localStoragePath, cfg.queryConcurrency, log.With(logger, "component", "activeQueryTracker")),
-			LookbackDelta:      time.Duration(cfg.lookbackDelta),
+			Logger:                 log.With(logger, "component", "query engine"),
+			Reg:                    prometheus.DefaultRegisterer,
+			MaxSamples:             cfg.queryMaxSamples,
+			MaxSampleBytes:         cfg.queryMaxSampleBytes,
+			Timeout:                time.Duration(cfg.queryTimeout),
+			ActiveQueryTracker:     promql.NewActiveQueryTracker(cfg.localStoragePath, cfg.queryConcurrency, log.With(logger, "component", "activeQueryTracker")),
+			LookbackDelta:          time.Duration(cfg.lookbackDelta),
+			AggregationConcurrency: cfg.queryAggrConcurrency,
 		}
 
 		queryEngine = promql.NewEngine(opts)
@@ -434,7 +489,13 @@ func main() {
 	)
 
 	reloaders := []func(cfg *config.Config) error{
-		remoteStorage.ApplyConfig,
+		func(cfg *config.Config) error {
+			if err := remoteStorage.ApplyConfig(cfg); err != nil {
+				return err
+			}
+			webHandler.SetRemoteWriteConfigured()
+			return nil
+		},
 		webHandler.ApplyConfig,
 		func(cfg *config.Config) error {
 			if cfg.GlobalConfig.QueryLogFile == "" {
@@ -457,7 +518,11 @@ func main() {
 			for _, v := range cfg.ScrapeConfigs {
 				c[v.JobName] = v.ServiceDiscoveryConfig
 			}
-			return discoveryManagerScrape.ApplyConfig(c)
+			if err := discoveryManagerScrape.ApplyConfig(c); err != nil {
+				return err
+			}
+			webHandler.SetDiscoveryInitialized()
+			return nil
 		},
 		notifierManager.ApplyConfig,
 		func(cfg *config.Config) error {
@@ -478,11 +543,15 @@ func main() {
 				}
 				files = append(files, fs...)
 			}
-			return ruleManager.Update(
+			if err := ruleManager.Update(
 				time.Duration(cfg.GlobalConfig.EvaluationInterval),
 				files,
 				cfg.GlobalConfig.ExternalLabels,
-			)
+			); err != nil {
+				return err
+			}
+			webHandler.SetRulesLoaded()
+			return nil
 		},
 	}
 
@@ -712,6 +781,7 @@ func main() {
 
 				startTimeMargin := int64(2 * time.Duration(cfg.tsdb.MinBlockDuration).Seconds() * 1000)
 				localStorage.Set(db, startTimeMargin)
+				webHandler.SetWALReplayed()
 				close(dbOpen)
 				<-cancel
 				return nil
@@ -1032,28 +1102,32 @@ func (s *readyStorage) Stats(statsByLabelName string) (*tsdb.Stats, error) {
 // tsdbOptions is tsdb.Option version with defined units.
 // This is required as tsdb.Option fields are unit agnostic (time).
 type tsdbOptions struct {
-	WALSegmentSize         units.Base2Bytes
-	RetentionDuration      model.Duration
-	MaxBytes               units.Base2Bytes
-	NoLockfile             bool
-	AllowOverlappingBlocks bool
-	WALCompression         bool
-	StripeSize             int
-	MinBlockDuration       model.Duration
-	MaxBlockDuration       model.Duration
+	WALSegmentSize          units.Base2Bytes
+	RetentionDuration       model.Duration
+	MaxBytes                units.Base2Bytes
+	NoLockfile              bool
+	AllowOverlappingBlocks  bool
+	WALCompression          bool
+	StripeSize              int
+	MinBlockDuration        model.Duration
+	MaxBlockDuration        model.Duration
+	BlockWarmupBandwidth    units.Base2Bytes
+	HeadChunkWriteQueueSize int
 }
 
 func (opts tsdbOptions) ToTSDBOptions() tsdb.Options {
 	return tsdb.Options{
-		WALSegmentSize:         int(opts.WALSegmentSize),
-		RetentionDuration:      int64(time.Duration(opts.RetentionDuration) / time.Millisecond),
-		MaxBytes:               int64(opts.MaxBytes),
-		NoLockfile:             opts.NoLockfile,
-		AllowOverlappingBlocks: opts.AllowOverlappingBlocks,
-		WALCompression:         opts.WALCompression,
-		StripeSize:             opts.StripeSize,
-		MinBlockDuration:       int64(time.Duration(opts.MinBlockDuration) / time.Millisecond),
-		MaxBlockDuration:       int64(time.Duration(opts.MaxBlockDuration) / time.Millisecond),
+		WALSegmentSize:            int(opts.WALSegmentSize),
+		RetentionDuration:         int64(time.Duration(opts.RetentionDuration) / time.Millisecond),
+		MaxBytes:                  int64(opts.MaxBytes),
+		NoLockfile:                opts.NoLockfile,
+		AllowOverlappingBlocks:    opts.AllowOverlappingBlocks,
+		WALCompression:            opts.WALCompression,
+		StripeSize:                opts.StripeSize,
+		MinBlockDuration:          int64(time.Duration(opts.MinBlockDuration) / time.Millisecond),
+		MaxBlockDuration:          int64(time.Duration(opts.MaxBlockDuration) / time.Millisecond),
+		BlockWarmupBytesPerSecond: int64(opts.BlockWarmupBandwidth),
+		HeadChunkWriteQueueSize:   opts.HeadChunkWriteQueueSize,
 	}
 }
 
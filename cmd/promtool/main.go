@@ -64,6 +64,17 @@ func main() {
 
 	checkMetricsCmd := checkCmd.Command("metrics", checkMetricsUsage)
 
+	checkSDCmd := checkCmd.Command("service-discovery", "Perform service discovery for the given job name and report the results, including relabeling.")
+	checkSDConfigFile := checkSDCmd.Arg(
+		"config-file",
+		"The prometheus config file.",
+	).Required().ExistingFile()
+	checkSDJobName := checkSDCmd.Arg(
+		"job",
+		"The job to run service discovery for.",
+	).Required().String()
+	checkSDTimeout := checkSDCmd.Flag("timeout", "The time to wait for discovery results.").Default("30s").Duration()
+
 	queryCmd := app.Command("query", "Run query against a Prometheus server.")
 	queryCmdFmt := queryCmd.Flag("format", "Output format of the query.").Short('o').Default("promql").Enum("promql", "json")
 	queryInstantCmd := queryCmd.Command("instant", "Run instant query.")
@@ -96,6 +107,18 @@ func main() {
 	queryLabelsServer := queryLabelsCmd.Arg("server", "Prometheus server to query.").Required().URL()
 	queryLabelsName := queryLabelsCmd.Arg("name", "Label name to provide label values for.").Required().String()
 
+	tsdbCmd := app.Command("tsdb", "Run tsdb commands.")
+	tsdbMigrateWALCmd := tsdbCmd.Command("migrate-wal", "Check that a WAL (and its checkpoint, if any) decode under the labels encoding this version of Prometheus understands.")
+	tsdbMigrateWALDir := tsdbMigrateWALCmd.Arg("wal-dir", "WAL directory.").Required().String()
+
+	rulesCmd := app.Command("rules", "Rule file utilities.")
+	rulesGraphCmd := rulesCmd.Command("graph", "Print the dependency graph between rules and the metrics they reference, to help find dead rules and evaluation-order hazards.")
+	rulesGraphFormat := rulesGraphCmd.Flag("format", "Output format for the graph.").Default("dot").Enum("dot", "json")
+	rulesGraphFiles := rulesGraphCmd.Arg(
+		"rule-files",
+		"The rule files to graph.",
+	).Required().ExistingFiles()
+
 	testCmd := app.Command("test", "Unit testing.")
 	testRulesCmd := testCmd.Command("rules", "Unit tests for rules.")
 	testRulesFiles := testRulesCmd.Arg(
@@ -123,6 +146,9 @@ func main() {
 	case checkMetricsCmd.FullCommand():
 		os.Exit(CheckMetrics())
 
+	case checkSDCmd.FullCommand():
+		os.Exit(CheckSD(*checkSDConfigFile, *checkSDJobName, *checkSDTimeout))
+
 	case queryInstantCmd.FullCommand():
 		os.Exit(QueryInstant(*queryServer, *queryExpr, p))
 
@@ -146,6 +172,18 @@ func main() {
 
 	case testRulesCmd.FullCommand():
 		os.Exit(RulesUnitTest(*testRulesFiles...))
+
+	case tsdbMigrateWALCmd.FullCommand():
+		os.Exit(MigrateWAL(*tsdbMigrateWALDir))
+
+	case rulesGraphCmd.FullCommand():
+		errs, _ := CheckRulesGraph(os.Stdout, *rulesGraphFormat, *rulesGraphFiles...)
+		if len(errs) > 0 {
+			for _, e := range errs {
+				fmt.Fprintln(os.Stderr, e.Error())
+			}
+			os.Exit(1)
+		}
 	}
 }
 
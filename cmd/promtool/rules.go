@@ -0,0 +1,178 @@
+// Copyright 2021 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// ruleGraphNode is a single vertex in a rules dependency graph: either a
+// recording rule or alert, identified by the metric/alert name it produces,
+// or a raw metric that some rule's expression references but that no rule
+// among the given files produces.
+type ruleGraphNode struct {
+	Name   string `json:"name"`
+	IsRule bool   `json:"isRule"`
+	File   string `json:"file,omitempty"`
+	Group  string `json:"group,omitempty"`
+}
+
+// ruleGraphEdge points from a rule to a name its expression references,
+// which is either another rule or a raw metric.
+type ruleGraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// ruleGraph is the dependency DAG between the rules in a set of rule files
+// and the metrics they read, as built by buildRuleGraph.
+type ruleGraph struct {
+	Nodes []ruleGraphNode `json:"nodes"`
+	Edges []ruleGraphEdge `json:"edges"`
+}
+
+// buildRuleGraph parses files and builds the dependency graph between their
+// recording rules/alerts and the metrics referenced by their expressions,
+// including metrics that are not produced by any rule in files. Nodes with
+// IsRule set to true but no incoming edge are candidates for dead rules;
+// an edge from a rule to another rule in the same or a later group is an
+// evaluation-order hazard, since within a group rules only ever see the
+// results of earlier groups.
+func buildRuleGraph(files ...string) (*ruleGraph, []error) {
+	var errs []error
+
+	nodes := map[string]ruleGraphNode{}
+	var order []string
+	edgeSet := map[ruleGraphEdge]struct{}{}
+
+	addNode := func(n ruleGraphNode) {
+		if _, ok := nodes[n.Name]; !ok {
+			order = append(order, n.Name)
+		}
+		nodes[n.Name] = n
+	}
+
+	for _, f := range files {
+		rgs, fErrs := rulefmt.ParseFile(f)
+		if fErrs != nil {
+			errs = append(errs, fErrs...)
+			continue
+		}
+		for _, rg := range rgs.Groups {
+			for _, r := range rg.Rules {
+				name := ruleMetric(r)
+				if name == "" {
+					continue
+				}
+				addNode(ruleGraphNode{Name: name, IsRule: true, File: f, Group: rg.Name})
+
+				expr, err := parser.ParseExpr(r.Expr.Value)
+				if err != nil {
+					errs = append(errs, errors.Wrapf(err, "%s: parsing expression for %q", f, name))
+					continue
+				}
+				for _, ref := range referencedMetrics(expr) {
+					edgeSet[ruleGraphEdge{From: name, To: ref}] = struct{}{}
+				}
+			}
+		}
+	}
+
+	// Referenced names that aren't produced by any rule are raw metrics;
+	// add them as leaf nodes so they show up in the graph.
+	for e := range edgeSet {
+		if _, ok := nodes[e.To]; !ok {
+			addNode(ruleGraphNode{Name: e.To})
+		}
+	}
+
+	sort.Strings(order)
+	g := &ruleGraph{Nodes: make([]ruleGraphNode, 0, len(order))}
+	for _, name := range order {
+		g.Nodes = append(g.Nodes, nodes[name])
+	}
+	for e := range edgeSet {
+		g.Edges = append(g.Edges, e)
+	}
+	sort.Slice(g.Edges, func(i, j int) bool {
+		if g.Edges[i].From != g.Edges[j].From {
+			return g.Edges[i].From < g.Edges[j].From
+		}
+		return g.Edges[i].To < g.Edges[j].To
+	})
+
+	return g, errs
+}
+
+// referencedMetrics returns the de-duplicated, sorted set of metric names
+// that expr's vector and matrix selectors match against.
+func referencedMetrics(expr parser.Expr) []string {
+	seen := map[string]struct{}{}
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		if vs, ok := node.(*parser.VectorSelector); ok && vs.Name != "" {
+			seen[vs.Name] = struct{}{}
+		}
+		return nil
+	})
+	names := make([]string, 0, len(seen))
+	for n := range seen {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CheckRulesGraph builds the dependency graph for files and writes it to w
+// in the given format ("dot" or "json"). It returns the number of errors
+// encountered while parsing the rule files; a partial graph is still
+// written for the files that did parse.
+func CheckRulesGraph(w io.Writer, format string, files ...string) ([]error, int) {
+	g, errs := buildRuleGraph(files...)
+
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(g); err != nil {
+			errs = append(errs, err)
+		}
+	default:
+		writeRuleGraphDOT(w, g)
+	}
+
+	return errs, len(g.Nodes)
+}
+
+func writeRuleGraphDOT(w io.Writer, g *ruleGraph) {
+	fmt.Fprintln(w, "digraph rules {")
+	for _, n := range g.Nodes {
+		shape := "ellipse"
+		if !n.IsRule {
+			shape = "box"
+		}
+		fmt.Fprintf(w, "  %q [shape=%s];\n", n.Name, shape)
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(w, "  %q -> %q;\n", e.From, e.To)
+	}
+	fmt.Fprintln(w, "}")
+}
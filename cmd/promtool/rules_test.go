@@ -0,0 +1,51 @@
+// Copyright 2021 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/prometheus/prometheus/util/testutil"
+)
+
+func TestBuildRuleGraph(t *testing.T) {
+	g, errs := buildRuleGraph("testdata/rules_graph.yml")
+	testutil.Equals(t, 0, len(errs))
+
+	var gotNodes []string
+	ruleNodes := map[string]bool{}
+	for _, n := range g.Nodes {
+		gotNodes = append(gotNodes, n.Name)
+		ruleNodes[n.Name] = n.IsRule
+	}
+	testutil.Equals(t, []string{
+		"HighRequestRate",
+		"http_requests_total",
+		"job:http_requests:rate5m",
+	}, gotNodes)
+
+	testutil.Assert(t, ruleNodes["HighRequestRate"], "HighRequestRate should be a rule")
+	testutil.Assert(t, ruleNodes["job:http_requests:rate5m"], "job:http_requests:rate5m should be a rule")
+	testutil.Assert(t, !ruleNodes["http_requests_total"], "http_requests_total should not be a rule")
+
+	testutil.Equals(t, []ruleGraphEdge{
+		{From: "HighRequestRate", To: "job:http_requests:rate5m"},
+		{From: "job:http_requests:rate5m", To: "http_requests_total"},
+	}, g.Edges)
+}
+
+func TestBuildRuleGraphParseError(t *testing.T) {
+	_, errs := buildRuleGraph("testdata/bad-expr.yml")
+	testutil.Assert(t, len(errs) > 0, "expected parse errors for an invalid rule file")
+}
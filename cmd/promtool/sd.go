@@ -0,0 +1,108 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/prometheus/prometheus/config"
+	"github.com/prometheus/prometheus/discovery"
+	sd_config "github.com/prometheus/prometheus/discovery/config"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/relabel"
+)
+
+// CheckSD performs service discovery for the given job and prints the
+// targets it finds, both as discovered and after the job's relabel_configs
+// have been applied, so that SD credentials and relabel rules can be
+// validated before a config is deployed. It runs the job's SD mechanisms
+// once and reports the first set of results received within sdTimeout.
+func CheckSD(sdConfigFile, sdJobName string, sdTimeout time.Duration) int {
+	cfg, err := config.LoadFile(sdConfigFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Cannot load config", err)
+		return 1
+	}
+
+	var scfg *config.ScrapeConfig
+	for _, v := range cfg.ScrapeConfigs {
+		if v.JobName == sdJobName {
+			scfg = v
+			break
+		}
+	}
+	if scfg == nil {
+		fmt.Fprintln(os.Stderr, "Job", sdJobName, "not found in config")
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sdTimeout)
+	defer cancel()
+
+	mgr := discovery.NewManager(ctx, log.NewNopLogger())
+	go func() {
+		if err := mgr.Run(); err != nil && err != context.Canceled && err != context.DeadlineExceeded {
+			fmt.Fprintln(os.Stderr, "Error running discovery manager", err)
+		}
+	}()
+
+	err = mgr.ApplyConfig(map[string]sd_config.ServiceDiscoveryConfig{
+		sdJobName: scfg.ServiceDiscoveryConfig,
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Could not apply config", err)
+		return 1
+	}
+
+	var targets []labels.Labels
+	select {
+	case tgs := <-mgr.SyncCh():
+		for _, tg := range tgs[sdJobName] {
+			for _, tlset := range tg.Targets {
+				lbls := make([]labels.Label, 0, len(tlset)+len(tg.Labels))
+				for ln, lv := range tlset {
+					lbls = append(lbls, labels.Label{Name: string(ln), Value: string(lv)})
+				}
+				for ln, lv := range tg.Labels {
+					if _, ok := tlset[ln]; !ok {
+						lbls = append(lbls, labels.Label{Name: string(ln), Value: string(lv)})
+					}
+				}
+				targets = append(targets, labels.New(lbls...))
+			}
+		}
+	case <-ctx.Done():
+		fmt.Fprintf(os.Stderr, "Timed out after %s waiting for targets, check connectivity to the SD source\n", sdTimeout)
+		return 1
+	}
+
+	fmt.Printf("Found %d target(s) for job %q:\n\n", len(targets), sdJobName)
+	for i, discovered := range targets {
+		fmt.Printf("Target #%d\n", i)
+		fmt.Println("  Discovered labels:", discovered)
+		if res := relabel.Process(discovered, scfg.RelabelConfigs...); res == nil {
+			fmt.Println("  Dropped by relabeling")
+		} else {
+			fmt.Println("  Labels after relabeling:", res)
+		}
+		fmt.Println()
+	}
+
+	return 0
+}
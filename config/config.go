@@ -30,6 +30,7 @@ import (
 	sd_config "github.com/prometheus/prometheus/discovery/config"
 	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/pkg/relabel"
+	"github.com/prometheus/prometheus/pkg/scraperules"
 )
 
 var (
@@ -375,6 +376,18 @@ type ScrapeConfig struct {
 	Scheme string `yaml:"scheme,omitempty"`
 	// More than this many samples post metric-relabelling will cause the scrape to fail.
 	SampleLimit uint `yaml:"sample_limit,omitempty"`
+	// The maximum lifetime of a persistent connection to a target. Once
+	// exceeded, idle connections are closed so the next scrape re-dials,
+	// which lets connections behind a load balancer get rebalanced
+	// periodically. Zero means connections are kept as long as the
+	// underlying transport allows.
+	MaxConnectionLifetime model.Duration `yaml:"max_connection_lifetime,omitempty"`
+	// Disables HTTP keep-alives, forcing a new connection for every scrape.
+	DisableKeepAlives bool `yaml:"disable_keepalives,omitempty"`
+	// Maximum number of this job's targets that may be scraped at once. Zero,
+	// the default, leaves this job bound only by the global scrape
+	// concurrency limit.
+	ScrapeConcurrencyLimit uint `yaml:"scrape_concurrency_limit,omitempty"`
 
 	// We cannot do proper Go type embedding below as the parser will then parse
 	// values arbitrarily into the overflow maps of further-down types.
@@ -386,6 +399,10 @@ type ScrapeConfig struct {
 	RelabelConfigs []*relabel.Config `yaml:"relabel_configs,omitempty"`
 	// List of metric relabel configurations.
 	MetricRelabelConfigs []*relabel.Config `yaml:"metric_relabel_configs,omitempty"`
+	// List of scrape-time aggregations ("scrape rules"), applied after
+	// metric relabeling, that pre-aggregate high-cardinality series before
+	// they reach the TSDB head.
+	ScrapeRules []*scraperules.Config `yaml:"scrape_rules,omitempty"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface.
@@ -435,6 +452,11 @@ func (c *ScrapeConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 			return errors.New("empty or null metric relabeling rule in scrape config")
 		}
 	}
+	for _, scfg := range c.ScrapeRules {
+		if scfg == nil {
+			return errors.New("empty or null scrape rule in scrape config")
+		}
+	}
 
 	// Add index to the static config target groups for unique identification
 	// within scrape pool.
@@ -447,8 +469,9 @@ func (c *ScrapeConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 
 // AlertingConfig configures alerting and alertmanager related configs.
 type AlertingConfig struct {
-	AlertRelabelConfigs []*relabel.Config   `yaml:"alert_relabel_configs,omitempty"`
-	AlertmanagerConfigs AlertmanagerConfigs `yaml:"alertmanagers,omitempty"`
+	AlertRelabelConfigs []*relabel.Config    `yaml:"alert_relabel_configs,omitempty"`
+	AlertmanagerConfigs AlertmanagerConfigs  `yaml:"alertmanagers,omitempty"`
+	RetryBudgets        []*RetryBudgetConfig `yaml:"retry_budgets,omitempty"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface.
@@ -466,9 +489,76 @@ func (c *AlertingConfig) UnmarshalYAML(unmarshal func(interface{}) error) error
 			return errors.New("empty or null alert relabeling rule")
 		}
 	}
+	for _, rbcfg := range c.RetryBudgets {
+		if rbcfg == nil {
+			return errors.New("empty or null retry budget")
+		}
+	}
+	return nil
+}
+
+// DefaultRetryBudgetConfig is the default retry budget applied to alerts
+// that don't match any configured RetryBudgetConfig: a single send attempt,
+// matching notifier behavior before retry budgets existed.
+var DefaultRetryBudgetConfig = RetryBudgetConfig{
+	MaxRetries: 0,
+	MinBackoff: model.Duration(1 * time.Second),
+	MaxBackoff: model.Duration(10 * time.Second),
+}
+
+// RetryBudgetConfig configures how aggressively alerts matching Match and
+// MatchRE should be retried when a send to an Alertmanager fails, e.g. to
+// retry page-severity alerts more aggressively than ticket-severity ones.
+// Match/MatchRE use the same semantics as Alertmanager's own route matching:
+// an alert matches when all of its Match and MatchRE constraints hold
+// against the alert's labels.
+type RetryBudgetConfig struct {
+	Match   map[string]string         `yaml:"match,omitempty"`
+	MatchRE map[string]relabel.Regexp `yaml:"match_re,omitempty"`
+
+	// MaxRetries is the number of additional attempts made after the first
+	// failed send. 0 means no retries.
+	MaxRetries int `yaml:"max_retries,omitempty"`
+	// MinBackoff and MaxBackoff bound the exponential backoff between retries.
+	MinBackoff model.Duration `yaml:"min_backoff,omitempty"`
+	MaxBackoff model.Duration `yaml:"max_backoff,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *RetryBudgetConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultRetryBudgetConfig
+	type plain RetryBudgetConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.MaxRetries < 0 {
+		return errors.New("retry budget max_retries must not be negative")
+	}
+	if c.MaxBackoff < c.MinBackoff {
+		return errors.New("retry budget max_backoff must not be smaller than min_backoff")
+	}
+	if len(c.Match) == 0 && len(c.MatchRE) == 0 {
+		return errors.New("retry budget requires at least one of match or match_re")
+	}
 	return nil
 }
 
+// Matches reports whether lbls satisfies every constraint in c.Match and
+// c.MatchRE.
+func (c *RetryBudgetConfig) Matches(lbls labels.Labels) bool {
+	for name, value := range c.Match {
+		if lbls.Get(name) != value {
+			return false
+		}
+	}
+	for name, re := range c.MatchRE {
+		if !re.MatchString(lbls.Get(name)) {
+			return false
+		}
+	}
+	return true
+}
+
 // AlertmanagerConfigs is a slice of *AlertmanagerConfig.
 type AlertmanagerConfigs []*AlertmanagerConfig
 
@@ -607,6 +697,13 @@ type FileSDConfig struct {
 }
 
 // RemoteWriteConfig is the configuration for writing to remote storage.
+//
+// Routing series to different remote_write endpoints based on label
+// matchers -- e.g. splitting series across regional long-term stores --
+// does not need a dedicated mechanism: WriteRelabelConfigs is evaluated
+// independently per RemoteWriteConfig, so an "action: keep" or
+// "action: drop" rule with a regex matcher on the relevant label already
+// selects which series each endpoint receives.
 type RemoteWriteConfig struct {
 	URL                 *config_util.URL  `yaml:"url"`
 	RemoteTimeout       model.Duration    `yaml:"remote_timeout,omitempty"`
@@ -82,6 +82,7 @@ var expectedConf = &Config{
 					Regex:        relabel.MustNewRegexp("expensive.*"),
 					Replacement:  "$1",
 					Action:       relabel.Drop,
+					HashFunction: relabel.MD5HashFunction,
 				},
 			},
 			QueueConfig: DefaultQueueConfig,
@@ -173,6 +174,7 @@ var expectedConf = &Config{
 					Regex:        relabel.MustNewRegexp("(.*)some-[regex]"),
 					Replacement:  "foo-${1}",
 					Action:       relabel.Replace,
+					HashFunction: relabel.MD5HashFunction,
 				}, {
 					SourceLabels: model.LabelNames{"abc"},
 					TargetLabel:  "cde",
@@ -180,18 +182,21 @@ var expectedConf = &Config{
 					Regex:        relabel.DefaultRelabelConfig.Regex,
 					Replacement:  relabel.DefaultRelabelConfig.Replacement,
 					Action:       relabel.Replace,
+					HashFunction: relabel.MD5HashFunction,
 				}, {
-					TargetLabel: "abc",
-					Separator:   ";",
-					Regex:       relabel.DefaultRelabelConfig.Regex,
-					Replacement: "static",
-					Action:      relabel.Replace,
+					TargetLabel:  "abc",
+					Separator:    ";",
+					Regex:        relabel.DefaultRelabelConfig.Regex,
+					Replacement:  "static",
+					Action:       relabel.Replace,
+					HashFunction: relabel.MD5HashFunction,
 				}, {
-					TargetLabel: "abc",
-					Separator:   ";",
-					Regex:       relabel.MustNewRegexp(""),
-					Replacement: "static",
-					Action:      relabel.Replace,
+					TargetLabel:  "abc",
+					Separator:    ";",
+					Regex:        relabel.MustNewRegexp(""),
+					Replacement:  "static",
+					Action:       relabel.Replace,
+					HashFunction: relabel.MD5HashFunction,
 				},
 			},
 		},
@@ -240,6 +245,7 @@ var expectedConf = &Config{
 					Separator:    ";",
 					Replacement:  relabel.DefaultRelabelConfig.Replacement,
 					Action:       relabel.Drop,
+					HashFunction: relabel.MD5HashFunction,
 				},
 				{
 					SourceLabels: model.LabelNames{"__address__"},
@@ -249,6 +255,7 @@ var expectedConf = &Config{
 					Modulus:      8,
 					Separator:    ";",
 					Action:       relabel.HashMod,
+					HashFunction: relabel.MD5HashFunction,
 				},
 				{
 					SourceLabels: model.LabelNames{"__tmp_hash"},
@@ -256,24 +263,28 @@ var expectedConf = &Config{
 					Separator:    ";",
 					Replacement:  relabel.DefaultRelabelConfig.Replacement,
 					Action:       relabel.Keep,
+					HashFunction: relabel.MD5HashFunction,
 				},
 				{
-					Regex:       relabel.MustNewRegexp("1"),
-					Separator:   ";",
-					Replacement: relabel.DefaultRelabelConfig.Replacement,
-					Action:      relabel.LabelMap,
+					Regex:        relabel.MustNewRegexp("1"),
+					Separator:    ";",
+					Replacement:  relabel.DefaultRelabelConfig.Replacement,
+					Action:       relabel.LabelMap,
+					HashFunction: relabel.MD5HashFunction,
 				},
 				{
-					Regex:       relabel.MustNewRegexp("d"),
-					Separator:   ";",
-					Replacement: relabel.DefaultRelabelConfig.Replacement,
-					Action:      relabel.LabelDrop,
+					Regex:        relabel.MustNewRegexp("d"),
+					Separator:    ";",
+					Replacement:  relabel.DefaultRelabelConfig.Replacement,
+					Action:       relabel.LabelDrop,
+					HashFunction: relabel.MD5HashFunction,
 				},
 				{
-					Regex:       relabel.MustNewRegexp("k"),
-					Separator:   ";",
-					Replacement: relabel.DefaultRelabelConfig.Replacement,
-					Action:      relabel.LabelKeep,
+					Regex:        relabel.MustNewRegexp("k"),
+					Separator:    ";",
+					Replacement:  relabel.DefaultRelabelConfig.Replacement,
+					Action:       relabel.LabelKeep,
+					HashFunction: relabel.MD5HashFunction,
 				},
 			},
 			MetricRelabelConfigs: []*relabel.Config{
@@ -283,6 +294,7 @@ var expectedConf = &Config{
 					Separator:    ";",
 					Replacement:  relabel.DefaultRelabelConfig.Replacement,
 					Action:       relabel.Drop,
+					HashFunction: relabel.MD5HashFunction,
 				},
 			},
 		},
@@ -326,6 +338,7 @@ var expectedConf = &Config{
 					TargetLabel:  "${1}",
 					Replacement:  "${2}",
 					Action:       relabel.Replace,
+					HashFunction: relabel.MD5HashFunction,
 				},
 			},
 		},
@@ -750,6 +763,12 @@ var expectedErrors = []struct {
 	}, {
 		filename: "modulus_missing.bad.yml",
 		errMsg:   "relabel configuration for hashmod requires non-zero modulus",
+	}, {
+		filename: "split_missing_named_group.bad.yml",
+		errMsg:   "relabel configuration for split action requires at least one named capture group in 'regex'",
+	}, {
+		filename: "hashmod_unknown_function.bad.yml",
+		errMsg:   "unknown hash function \"sha1\"",
 	}, {
 		filename: "labelkeep.bad.yml",
 		errMsg:   "labelkeep action requires only 'regex', and no other fields",
@@ -927,6 +946,14 @@ var expectedErrors = []struct {
 		filename: "empty_alertmanager_relabel_config.bad.yml",
 		errMsg:   "empty or null Alertmanager target relabeling rule",
 	},
+	{
+		filename: "empty_retry_budget.bad.yml",
+		errMsg:   "empty or null retry budget",
+	},
+	{
+		filename: "retry_budget_no_match.bad.yml",
+		errMsg:   "retry budget requires at least one of match or match_re",
+	},
 	{
 		filename: "empty_rw_relabel_config.bad.yml",
 		errMsg:   "empty or null relabeling rule in remote write config",
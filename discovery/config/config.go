@@ -22,11 +22,13 @@ import (
 	"github.com/prometheus/prometheus/discovery/dns"
 	"github.com/prometheus/prometheus/discovery/dockerswarm"
 	"github.com/prometheus/prometheus/discovery/ec2"
+	"github.com/prometheus/prometheus/discovery/ecs"
 	"github.com/prometheus/prometheus/discovery/file"
 	"github.com/prometheus/prometheus/discovery/gce"
 	"github.com/prometheus/prometheus/discovery/kubernetes"
 	"github.com/prometheus/prometheus/discovery/marathon"
 	"github.com/prometheus/prometheus/discovery/openstack"
+	"github.com/prometheus/prometheus/discovery/sql"
 	"github.com/prometheus/prometheus/discovery/targetgroup"
 	"github.com/prometheus/prometheus/discovery/triton"
 	"github.com/prometheus/prometheus/discovery/zookeeper"
@@ -58,12 +60,16 @@ type ServiceDiscoveryConfig struct {
 	GCESDConfigs []*gce.SDConfig `yaml:"gce_sd_configs,omitempty"`
 	// List of EC2 service discovery configurations.
 	EC2SDConfigs []*ec2.SDConfig `yaml:"ec2_sd_configs,omitempty"`
+	// List of ECS service discovery configurations.
+	ECSSDConfigs []*ecs.SDConfig `yaml:"ecs_sd_configs,omitempty"`
 	// List of OpenStack service discovery configurations.
 	OpenstackSDConfigs []*openstack.SDConfig `yaml:"openstack_sd_configs,omitempty"`
 	// List of Azure service discovery configurations.
 	AzureSDConfigs []*azure.SDConfig `yaml:"azure_sd_configs,omitempty"`
 	// List of Triton service discovery configurations.
 	TritonSDConfigs []*triton.SDConfig `yaml:"triton_sd_configs,omitempty"`
+	// List of SQL service discovery configurations.
+	SQLSDConfigs []*sql.SDConfig `yaml:"sql_sd_configs,omitempty"`
 }
 
 // Validate validates the ServiceDiscoveryConfig.
@@ -93,6 +99,11 @@ func (c *ServiceDiscoveryConfig) Validate() error {
 			return errors.New("empty or null section in ec2_sd_configs")
 		}
 	}
+	for _, cfg := range c.ECSSDConfigs {
+		if cfg == nil {
+			return errors.New("empty or null section in ecs_sd_configs")
+		}
+	}
 	for _, cfg := range c.FileSDConfigs {
 		if cfg == nil {
 			return errors.New("empty or null section in file_sd_configs")
@@ -128,6 +139,11 @@ func (c *ServiceDiscoveryConfig) Validate() error {
 			return errors.New("empty or null section in serverset_sd_configs")
 		}
 	}
+	for _, cfg := range c.SQLSDConfigs {
+		if cfg == nil {
+			return errors.New("empty or null section in sql_sd_configs")
+		}
+	}
 	for _, cfg := range c.StaticConfigs {
 		if cfg == nil {
 			return errors.New("empty or null section in static_configs")
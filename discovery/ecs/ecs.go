@@ -0,0 +1,384 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/go-kit/kit/log"
+	"github.com/pkg/errors"
+	config_util "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/prometheus/discovery/refresh"
+	"github.com/prometheus/prometheus/discovery/targetgroup"
+	"github.com/prometheus/prometheus/util/strutil"
+)
+
+const (
+	ecsLabel                       = model.MetaLabelPrefix + "ecs_"
+	ecsLabelCluster                = ecsLabel + "cluster"
+	ecsLabelService                = ecsLabel + "service"
+	ecsLabelTaskARN                = ecsLabel + "task_arn"
+	ecsLabelTaskDefinitionFamily   = ecsLabel + "task_definition_family"
+	ecsLabelTaskDefinitionRevision = ecsLabel + "task_definition_revision"
+	ecsLabelLaunchType             = ecsLabel + "launch_type"
+	ecsLabelLastStatus             = ecsLabel + "last_status"
+	ecsLabelAZ                     = ecsLabel + "availability_zone"
+	ecsLabelContainerName          = ecsLabel + "container_name"
+	ecsLabelTag                    = ecsLabel + "tag_"
+
+	describeTasksBatchSize = 100
+)
+
+// DefaultSDConfig is the default ECS SD configuration.
+var DefaultSDConfig = SDConfig{
+	Port:            80,
+	RefreshInterval: model.Duration(60 * time.Second),
+}
+
+// SDConfig is the configuration for ECS based service discovery.
+type SDConfig struct {
+	Endpoint        string             `yaml:"endpoint"`
+	Region          string             `yaml:"region"`
+	AccessKey       string             `yaml:"access_key,omitempty"`
+	SecretKey       config_util.Secret `yaml:"secret_key,omitempty"`
+	Profile         string             `yaml:"profile,omitempty"`
+	RoleARN         string             `yaml:"role_arn,omitempty"`
+	RefreshInterval model.Duration     `yaml:"refresh_interval,omitempty"`
+	Port            int                `yaml:"port"`
+	// Clusters restricts discovery to the given cluster names or ARNs. If
+	// empty, all clusters visible to the credentials in use are discovered.
+	Clusters []string `yaml:"clusters,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *SDConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultSDConfig
+	type plain SDConfig
+	err := unmarshal((*plain)(c))
+	if err != nil {
+		return err
+	}
+	if c.Region == "" {
+		return errors.New("ECS SD configuration requires a region")
+	}
+	return nil
+}
+
+// Discovery periodically performs ECS-SD requests. It implements the
+// Discoverer interface.
+type Discovery struct {
+	*refresh.Discovery
+	aws      *aws.Config
+	interval time.Duration
+	profile  string
+	roleARN  string
+	port     int
+	clusters []string
+}
+
+// NewDiscovery returns a new ECS Discovery which periodically refreshes its targets.
+func NewDiscovery(conf *SDConfig, logger log.Logger) *Discovery {
+	creds := credentials.NewStaticCredentials(conf.AccessKey, string(conf.SecretKey), "")
+	if conf.AccessKey == "" && conf.SecretKey == "" {
+		creds = nil
+	}
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+	d := &Discovery{
+		aws: &aws.Config{
+			Endpoint:    &conf.Endpoint,
+			Region:      &conf.Region,
+			Credentials: creds,
+		},
+		profile:  conf.Profile,
+		roleARN:  conf.RoleARN,
+		interval: time.Duration(conf.RefreshInterval),
+		port:     conf.Port,
+		clusters: conf.Clusters,
+	}
+	d.Discovery = refresh.NewDiscovery(
+		logger,
+		"ecs",
+		time.Duration(conf.RefreshInterval),
+		d.refresh,
+	)
+	return d
+}
+
+func (d *Discovery) refresh(ctx context.Context) ([]*targetgroup.Group, error) {
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:  *d.aws,
+		Profile: d.profile,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create aws session")
+	}
+
+	var awsCreds *credentials.Credentials
+	if d.roleARN != "" {
+		awsCreds = stscreds.NewCredentials(sess, d.roleARN)
+	}
+	ecsClient := ecs.New(sess, &aws.Config{Credentials: awsCreds})
+	ec2Client := ec2.New(sess, &aws.Config{Credentials: awsCreds})
+
+	clusters, err := d.clusterARNs(ctx, ecsClient)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list ECS clusters")
+	}
+
+	tg := &targetgroup.Group{
+		Source: *d.aws.Region,
+	}
+
+	for _, cluster := range clusters {
+		if err := d.addClusterTasks(ctx, ecsClient, ec2Client, cluster, tg); err != nil {
+			return nil, errors.Wrapf(err, "could not describe tasks for cluster %s", cluster)
+		}
+	}
+	return []*targetgroup.Group{tg}, nil
+}
+
+// clusterARNs returns the explicitly configured clusters, or discovers every
+// cluster visible to the credentials in use if none were configured.
+func (d *Discovery) clusterARNs(ctx context.Context, ecsClient *ecs.ECS) ([]string, error) {
+	if len(d.clusters) > 0 {
+		return d.clusters, nil
+	}
+	var clusters []string
+	err := ecsClient.ListClustersPagesWithContext(ctx, &ecs.ListClustersInput{}, func(p *ecs.ListClustersOutput, lastPage bool) bool {
+		for _, arn := range p.ClusterArns {
+			clusters = append(clusters, *arn)
+		}
+		return true
+	})
+	return clusters, err
+}
+
+func (d *Discovery) addClusterTasks(ctx context.Context, ecsClient *ecs.ECS, ec2Client *ec2.EC2, cluster string, tg *targetgroup.Group) error {
+	var taskARNs []string
+	err := ecsClient.ListTasksPagesWithContext(ctx, &ecs.ListTasksInput{
+		Cluster:       aws.String(cluster),
+		DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+	}, func(p *ecs.ListTasksOutput, lastPage bool) bool {
+		for _, arn := range p.TaskArns {
+			taskARNs = append(taskARNs, *arn)
+		}
+		return true
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not list tasks")
+	}
+	if len(taskARNs) == 0 {
+		return nil
+	}
+
+	// privateIPByContainerInstance is populated lazily, since most clusters
+	// only need it for tasks that aren't running in awsvpc network mode.
+	var privateIPByContainerInstance map[string]string
+
+	for i := 0; i < len(taskARNs); i += describeTasksBatchSize {
+		end := i + describeTasksBatchSize
+		if end > len(taskARNs) {
+			end = len(taskARNs)
+		}
+		out, err := ecsClient.DescribeTasksWithContext(ctx, &ecs.DescribeTasksInput{
+			Cluster: aws.String(cluster),
+			Tasks:   aws.StringSlice(taskARNs[i:end]),
+			Include: aws.StringSlice([]string{ecs.TaskFieldTags}),
+		})
+		if err != nil {
+			return errors.Wrap(err, "could not describe tasks")
+		}
+
+		for _, task := range out.Tasks {
+			addr := taskPrivateIP(task)
+			if addr == "" {
+				if privateIPByContainerInstance == nil {
+					privateIPByContainerInstance, err = containerInstancePrivateIPs(ctx, ecsClient, ec2Client, cluster, out.Tasks)
+					if err != nil {
+						return errors.Wrap(err, "could not resolve container instance IPs")
+					}
+				}
+				if task.ContainerInstanceArn != nil {
+					addr = privateIPByContainerInstance[*task.ContainerInstanceArn]
+				}
+			}
+			if addr == "" {
+				continue
+			}
+
+			for _, c := range task.Containers {
+				labels := model.LabelSet{
+					model.AddressLabel: model.LabelValue(net.JoinHostPort(addr, fmt.Sprintf("%d", d.port))),
+					ecsLabelCluster:    model.LabelValue(cluster),
+					ecsLabelTaskARN:    model.LabelValue(*task.TaskArn),
+				}
+				if c.Name != nil {
+					labels[ecsLabelContainerName] = model.LabelValue(*c.Name)
+				}
+				if task.LaunchType != nil {
+					labels[ecsLabelLaunchType] = model.LabelValue(*task.LaunchType)
+				}
+				if task.LastStatus != nil {
+					labels[ecsLabelLastStatus] = model.LabelValue(*task.LastStatus)
+				}
+				if task.AvailabilityZone != nil {
+					labels[ecsLabelAZ] = model.LabelValue(*task.AvailabilityZone)
+				}
+				if service, ok := serviceName(task); ok {
+					labels[ecsLabelService] = model.LabelValue(service)
+				}
+				if family, revision, ok := taskDefinitionFamilyRevision(task); ok {
+					labels[ecsLabelTaskDefinitionFamily] = model.LabelValue(family)
+					labels[ecsLabelTaskDefinitionRevision] = model.LabelValue(revision)
+				}
+				for _, t := range task.Tags {
+					if t == nil || t.Key == nil || t.Value == nil {
+						continue
+					}
+					name := strutil.SanitizeLabelName(*t.Key)
+					labels[ecsLabelTag+model.LabelName(name)] = model.LabelValue(*t.Value)
+				}
+				tg.Targets = append(tg.Targets, labels)
+			}
+		}
+	}
+	return nil
+}
+
+// taskPrivateIP returns the private IPv4 address attached to tasks running
+// in awsvpc network mode (the case for Fargate, and optionally for EC2).
+func taskPrivateIP(task *ecs.Task) string {
+	for _, a := range task.Attachments {
+		if a.Type == nil || *a.Type != "ElasticNetworkInterface" {
+			continue
+		}
+		for _, d := range a.Details {
+			if d.Name != nil && *d.Name == "privateIPv4Address" && d.Value != nil {
+				return *d.Value
+			}
+		}
+	}
+	return ""
+}
+
+// containerInstancePrivateIPs maps the container instance ARNs referenced by
+// tasks to the private IP of the EC2 instance backing them. It is only
+// needed for tasks that are not running in awsvpc network mode.
+func containerInstancePrivateIPs(ctx context.Context, ecsClient *ecs.ECS, ec2Client *ec2.EC2, cluster string, tasks []*ecs.Task) (map[string]string, error) {
+	instanceARNs := map[string]struct{}{}
+	for _, task := range tasks {
+		if task.ContainerInstanceArn != nil {
+			instanceARNs[*task.ContainerInstanceArn] = struct{}{}
+		}
+	}
+	if len(instanceARNs) == 0 {
+		return map[string]string{}, nil
+	}
+	arns := make([]string, 0, len(instanceARNs))
+	for arn := range instanceARNs {
+		arns = append(arns, arn)
+	}
+
+	ciOut, err := ecsClient.DescribeContainerInstancesWithContext(ctx, &ecs.DescribeContainerInstancesInput{
+		Cluster:            aws.String(cluster),
+		ContainerInstances: aws.StringSlice(arns),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not describe container instances")
+	}
+
+	ec2InstanceID := map[string]string{}
+	var ec2IDs []*string
+	for _, ci := range ciOut.ContainerInstances {
+		if ci.Ec2InstanceId == nil || ci.ContainerInstanceArn == nil {
+			continue
+		}
+		ec2InstanceID[*ci.ContainerInstanceArn] = *ci.Ec2InstanceId
+		ec2IDs = append(ec2IDs, ci.Ec2InstanceId)
+	}
+	if len(ec2IDs) == 0 {
+		return map[string]string{}, nil
+	}
+
+	privateIP := map[string]string{}
+	err = ec2Client.DescribeInstancesPagesWithContext(ctx, &ec2.DescribeInstancesInput{InstanceIds: ec2IDs}, func(p *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, r := range p.Reservations {
+			for _, inst := range r.Instances {
+				if inst.InstanceId != nil && inst.PrivateIpAddress != nil {
+					privateIP[*inst.InstanceId] = *inst.PrivateIpAddress
+				}
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not describe EC2 instances")
+	}
+
+	result := make(map[string]string, len(ec2InstanceID))
+	for arn, id := range ec2InstanceID {
+		if ip, ok := privateIP[id]; ok {
+			result[arn] = ip
+		}
+	}
+	return result, nil
+}
+
+// serviceName extracts the owning service name from a task's group, which
+// ECS sets to "service:<name>" for tasks started by a service.
+func serviceName(task *ecs.Task) (string, bool) {
+	if task.Group == nil {
+		return "", false
+	}
+	name := strings.TrimPrefix(*task.Group, "service:")
+	if name == *task.Group {
+		return "", false
+	}
+	return name, true
+}
+
+// taskDefinitionFamilyRevision splits a task definition ARN of the form
+// ".../task-definition/family:revision" into its family and revision.
+func taskDefinitionFamilyRevision(task *ecs.Task) (family, revision string, ok bool) {
+	if task.TaskDefinitionArn == nil {
+		return "", "", false
+	}
+	parts := strings.Split(*task.TaskDefinitionArn, "/")
+	last := parts[len(parts)-1]
+	idx := strings.LastIndex(last, ":")
+	if idx < 0 {
+		return "", "", false
+	}
+	family = last[:idx]
+	revision = last[idx+1:]
+	if _, err := strconv.Atoi(revision); err != nil {
+		return "", "", false
+	}
+	return family, revision, true
+}
@@ -14,6 +14,7 @@
 package file
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -21,6 +22,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -38,6 +40,7 @@ import (
 
 var (
 	patFileSDName = regexp.MustCompile(`^[^*]*(\*[^/]*)?\.(json|yml|yaml|JSON|YML|YAML)$`)
+	patYAMLLine   = regexp.MustCompile(`line (\d+):`)
 
 	// DefaultSDConfig is the default file SD configuration.
 	DefaultSDConfig = SDConfig{
@@ -45,6 +48,69 @@ var (
 	}
 )
 
+// fileFormatError is returned by readFile when a file_sd input file fails
+// validation -- either it isn't well-formed JSON/YAML, or it parses into
+// something that isn't a valid list of target groups. Line is the 1-based
+// line the problem was found on, or 0 if none could be determined, e.g. for
+// a semantic error that is only apparent once the whole file has been
+// parsed.
+type fileFormatError struct {
+	filename string
+	line     int
+	err      error
+}
+
+func (e *fileFormatError) Error() string {
+	if e.line > 0 {
+		return fmt.Sprintf("%s: line %d: %s", e.filename, e.line, e.err)
+	}
+	return fmt.Sprintf("%s: %s", e.filename, e.err)
+}
+
+// jsonErrorLine converts the byte offset of a json.SyntaxError or
+// json.UnmarshalTypeError into a 1-based line number.
+func jsonErrorLine(content []byte, offset int64) int {
+	if offset <= 0 {
+		return 0
+	}
+	if int(offset) > len(content) {
+		offset = int64(len(content))
+	}
+	return 1 + bytes.Count(content[:offset], []byte("\n"))
+}
+
+// yamlErrorLine extracts the line number yaml.v2 embeds in its syntax and
+// type error messages, e.g. "yaml: line 3: did not find expected key".
+func yamlErrorLine(err error) int {
+	m := patYAMLLine.FindStringSubmatch(err.Error())
+	if m == nil {
+		return 0
+	}
+	line, convErr := strconv.Atoi(m[1])
+	if convErr != nil {
+		return 0
+	}
+	return line
+}
+
+// validateTargetGroup applies the schema file_sd input is expected to
+// conform to beyond what json/yaml unmarshaling already enforces: declared
+// labels must be valid, and every target must have a non-empty address.
+func validateTargetGroup(tg *targetgroup.Group) error {
+	if err := tg.Labels.Validate(); err != nil {
+		return errors.Wrap(err, "invalid labels")
+	}
+	for _, t := range tg.Targets {
+		if err := t.Validate(); err != nil {
+			return errors.Wrap(err, "invalid target")
+		}
+		if t[model.AddressLabel] == "" {
+			return errors.New("target has no address")
+		}
+	}
+	return nil
+}
+
 // SDConfig is the configuration for file based discovery.
 type SDConfig struct {
 	Files           []string       `yaml:"files"`
@@ -144,12 +210,20 @@ var (
 			Name: "prometheus_sd_file_read_errors_total",
 			Help: "The number of File-SD read errors.",
 		})
+	fileSDInvalid = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "prometheus_sd_file_invalid",
+			Help: "Whether the last read of the file currently fails schema validation (1) or not (0), by file.",
+		},
+		[]string{"filename"},
+	)
 	fileSDTimeStamp = NewTimestampCollector()
 )
 
 func init() {
 	prometheus.MustRegister(fileSDScanDuration)
 	prometheus.MustRegister(fileSDReadErrorsCount)
+	prometheus.MustRegister(fileSDInvalid)
 	prometheus.MustRegister(fileSDTimeStamp)
 }
 
@@ -167,7 +241,13 @@ type Discovery struct {
 	// and how many target groups they contained.
 	// This is used to detect deleted target groups.
 	lastRefresh map[string]int
-	logger      log.Logger
+	// lastGroups stores the last successfully read and validated target
+	// groups for each file. If a subsequent read of a file fails or
+	// produces invalid input, the file's previous entry here is kept and
+	// reused rather than dropped, so a single bad write doesn't blow away
+	// otherwise-healthy targets.
+	lastGroups map[string][]*targetgroup.Group
+	logger     log.Logger
 }
 
 // NewDiscovery returns a new file discovery for the given paths.
@@ -180,6 +260,7 @@ func NewDiscovery(conf *SDConfig, logger log.Logger) *Discovery {
 		paths:      conf.Files,
 		interval:   time.Duration(conf.RefreshInterval),
 		timestamps: make(map[string]float64),
+		lastGroups: make(map[string][]*targetgroup.Group),
 		logger:     logger,
 	}
 	fileSDTimeStamp.addDiscoverer(disc)
@@ -319,12 +400,26 @@ func (d *Discovery) refresh(ctx context.Context, ch chan<- []*targetgroup.Group)
 		tgroups, err := d.readFile(p)
 		if err != nil {
 			fileSDReadErrorsCount.Inc()
+			fileSDInvalid.WithLabelValues(p).Set(1)
 
 			level.Error(d.logger).Log("msg", "Error reading file", "path", p, "err", err)
-			// Prevent deletion down below.
-			ref[p] = d.lastRefresh[p]
+			// Keep serving the last known-good target groups for this file
+			// instead of dropping them.
+			if last, ok := d.lastGroups[p]; ok {
+				select {
+				case ch <- last:
+				case <-ctx.Done():
+					return
+				}
+				ref[p] = len(last)
+			} else {
+				ref[p] = d.lastRefresh[p]
+			}
 			continue
 		}
+		fileSDInvalid.WithLabelValues(p).Set(0)
+		d.lastGroups[p] = tgroups
+
 		select {
 		case ch <- tgroups:
 		case <-ctx.Done():
@@ -339,6 +434,8 @@ func (d *Discovery) refresh(ctx context.Context, ch chan<- []*targetgroup.Group)
 		if !ok || n > m {
 			level.Debug(d.logger).Log("msg", "file_sd refresh found file that should be removed", "file", f)
 			d.deleteTimestamp(f)
+			delete(d.lastGroups, f)
+			fileSDInvalid.DeleteLabelValues(f)
 			for i := m; i < n; i++ {
 				select {
 				case ch <- []*targetgroup.Group{{Source: fileSource(f, i)}}:
@@ -377,11 +474,18 @@ func (d *Discovery) readFile(filename string) ([]*targetgroup.Group, error) {
 	switch ext := filepath.Ext(filename); strings.ToLower(ext) {
 	case ".json":
 		if err := json.Unmarshal(content, &targetGroups); err != nil {
-			return nil, err
+			line := 0
+			switch jerr := err.(type) {
+			case *json.SyntaxError:
+				line = jsonErrorLine(content, jerr.Offset)
+			case *json.UnmarshalTypeError:
+				line = jsonErrorLine(content, jerr.Offset)
+			}
+			return nil, &fileFormatError{filename: filename, line: line, err: err}
 		}
 	case ".yml", ".yaml":
 		if err := yaml.UnmarshalStrict(content, &targetGroups); err != nil {
-			return nil, err
+			return nil, &fileFormatError{filename: filename, line: yamlErrorLine(err), err: err}
 		}
 	default:
 		panic(errors.Errorf("discovery.File.readFile: unhandled file extension %q", ext))
@@ -389,8 +493,10 @@ func (d *Discovery) readFile(filename string) ([]*targetgroup.Group, error) {
 
 	for i, tg := range targetGroups {
 		if tg == nil {
-			err = errors.New("nil target group item found")
-			return nil, err
+			return nil, &fileFormatError{filename: filename, err: errors.New("nil target group item found")}
+		}
+		if err := validateTargetGroup(tg); err != nil {
+			return nil, &fileFormatError{filename: filename, err: errors.Wrapf(err, "invalid target group %d", i)}
 		}
 
 		tg.Source = fileSource(filename, i)
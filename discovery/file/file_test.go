@@ -326,6 +326,8 @@ func TestInvalidFile(t *testing.T) {
 	for _, tc := range []string{
 		"fixtures/invalid_nil.yml",
 		"fixtures/invalid_nil.json",
+		"fixtures/invalid_target.yml",
+		"fixtures/invalid_target.json",
 	} {
 		tc := tc
 		t.Run(tc, func(t *testing.T) {
@@ -405,6 +407,25 @@ func TestInvalidFileUpdate(t *testing.T) {
 	}
 }
 
+func TestInvalidFileKeepsLastKnownGoodTargets(t *testing.T) {
+	t.Parallel()
+
+	runner := newTestRunner(t)
+	sdFile := runner.copyFile("fixtures/valid.yml")
+
+	runner.run("*.yml")
+	defer runner.stop()
+
+	// Verify that we receive the initial target groups.
+	runner.requireUpdate(time.Time{}, validTg(sdFile))
+
+	// Writing a file that fails schema validation must not drop the
+	// last known-good target groups.
+	ref := runner.lastReceive()
+	runner.copyFileTo("fixtures/invalid_target.yml", "valid.yml")
+	runner.requireUpdate(ref, validTg(sdFile))
+}
+
 func TestUpdateFileWithPartialWrites(t *testing.T) {
 	t.Parallel()
 
@@ -449,6 +470,30 @@ func TestUpdateFileWithPartialWrites(t *testing.T) {
 	)
 }
 
+func TestReadFileErrorLineNumbers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "prometheus-file-sd-line")
+	testutil.Ok(t, err)
+	defer os.RemoveAll(dir)
+
+	d := NewDiscovery(&SDConfig{Files: []string{filepath.Join(dir, "*.yml")}}, nil)
+
+	yml := filepath.Join(dir, "bad.yml")
+	testutil.Ok(t, ioutil.WriteFile(yml, []byte("- targets: ['localhost:9090']\n  labels: [not, a, map]\n"), 0o644))
+	_, err = d.readFile(yml)
+	testutil.NotOk(t, err)
+	ffErr, ok := err.(*fileFormatError)
+	testutil.Assert(t, ok, "expected a *fileFormatError, got %T", err)
+	testutil.Equals(t, 2, ffErr.line)
+
+	jsonFile := filepath.Join(dir, "bad.json")
+	testutil.Ok(t, ioutil.WriteFile(jsonFile, []byte("[\n  {\n    \"targets\": [\n"), 0o644))
+	_, err = d.readFile(jsonFile)
+	testutil.NotOk(t, err)
+	ffErr, ok = err.(*fileFormatError)
+	testutil.Assert(t, ok, "expected a *fileFormatError, got %T", err)
+	testutil.Equals(t, 4, ffErr.line)
+}
+
 func TestRemoveFile(t *testing.T) {
 	t.Parallel()
 
@@ -15,6 +15,7 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"strconv"
 
@@ -154,6 +155,7 @@ const (
 	nodeAnnotationPrefix        = metaLabelPrefix + "node_annotation_"
 	nodeAnnotationPresentPrefix = metaLabelPrefix + "node_annotationpresent_"
 	nodeAddressPrefix           = metaLabelPrefix + "node_address_"
+	nodeTaintPrefix             = metaLabelPrefix + "node_taint_"
 )
 
 func nodeLabels(n *apiv1.Node) model.LabelSet {
@@ -173,6 +175,13 @@ func nodeLabels(n *apiv1.Node) model.LabelSet {
 		ls[model.LabelName(nodeAnnotationPrefix+ln)] = lv(v)
 		ls[model.LabelName(nodeAnnotationPresentPrefix+ln)] = presentValue
 	}
+
+	for _, taint := range n.Spec.Taints {
+		key := strutil.SanitizeLabelName(taint.Key)
+		ls[model.LabelName(nodeTaintPrefix+key+"_key")] = lv(taint.Key)
+		ls[model.LabelName(nodeTaintPrefix+key+"_value")] = lv(taint.Value)
+		ls[model.LabelName(nodeTaintPrefix+key+"_effect")] = lv(string(taint.Effect))
+	}
 	return ls
 }
 
@@ -197,6 +206,12 @@ func (n *Node) buildNode(node *apiv1.Node) *targetgroup.Group {
 	for ty, a := range addrMap {
 		ln := strutil.SanitizeLabelName(nodeAddressPrefix + string(ty))
 		t[model.LabelName(ln)] = lv(a[0])
+		// Dual-stack nodes report more than one address per type, e.g. an
+		// IPv4 and an IPv6 NodeInternalIP. Expose the rest under an
+		// index-suffixed label so they aren't silently dropped.
+		for i, addr := range a[1:] {
+			t[model.LabelName(fmt.Sprintf("%s_%d", ln, i+1))] = lv(addr)
+		}
 	}
 	tg.Targets = append(tg.Targets, t)
 
@@ -171,3 +171,84 @@ func TestNodeDiscoveryUpdate(t *testing.T) {
 		},
 	}.Run(t)
 }
+
+func TestNodeDiscoveryTaints(t *testing.T) {
+	n, c := makeDiscovery(RoleNode, NamespaceDiscovery{})
+
+	k8sDiscoveryTest{
+		discovery: n,
+		afterStart: func() {
+			obj := makeNode(
+				"test0",
+				"1.2.3.4",
+				map[string]string{},
+				map[string]string{},
+			)
+			obj.Spec.Taints = []v1.Taint{
+				{
+					Key:    "node-role.kubernetes.io/master",
+					Value:  "",
+					Effect: v1.TaintEffectNoSchedule,
+				},
+			}
+			c.CoreV1().Nodes().Create(context.Background(), obj, metav1.CreateOptions{})
+		},
+		expectedMaxItems: 1,
+		expectedRes: map[string]*targetgroup.Group{
+			"node/test0": {
+				Targets: []model.LabelSet{
+					{
+						"__address__": "1.2.3.4:10250",
+						"instance":    "test0",
+						"__meta_kubernetes_node_address_InternalIP": "1.2.3.4",
+					},
+				},
+				Labels: model.LabelSet{
+					"__meta_kubernetes_node_name": "test0",
+					"__meta_kubernetes_node_taint_node_role_kubernetes_io_master_key":    "node-role.kubernetes.io/master",
+					"__meta_kubernetes_node_taint_node_role_kubernetes_io_master_value":  "",
+					"__meta_kubernetes_node_taint_node_role_kubernetes_io_master_effect": "NoSchedule",
+				},
+				Source: "node/test0",
+			},
+		},
+	}.Run(t)
+}
+
+func TestNodeDiscoveryDualStackAddress(t *testing.T) {
+	n, c := makeDiscovery(RoleNode, NamespaceDiscovery{})
+
+	k8sDiscoveryTest{
+		discovery: n,
+		afterStart: func() {
+			obj := makeNode(
+				"test0",
+				"1.2.3.4",
+				map[string]string{},
+				map[string]string{},
+			)
+			obj.Status.Addresses = append(obj.Status.Addresses, v1.NodeAddress{
+				Type:    v1.NodeInternalIP,
+				Address: "::1",
+			})
+			c.CoreV1().Nodes().Create(context.Background(), obj, metav1.CreateOptions{})
+		},
+		expectedMaxItems: 1,
+		expectedRes: map[string]*targetgroup.Group{
+			"node/test0": {
+				Targets: []model.LabelSet{
+					{
+						"__address__": "1.2.3.4:10250",
+						"instance":    "test0",
+						"__meta_kubernetes_node_address_InternalIP":   "1.2.3.4",
+						"__meta_kubernetes_node_address_InternalIP_1": "::1",
+					},
+				},
+				Labels: model.LabelSet{
+					"__meta_kubernetes_node_name": "test0",
+				},
+				Source: "node/test0",
+			},
+		},
+	}.Run(t)
+}
@@ -33,11 +33,13 @@ import (
 	"github.com/prometheus/prometheus/discovery/dns"
 	"github.com/prometheus/prometheus/discovery/dockerswarm"
 	"github.com/prometheus/prometheus/discovery/ec2"
+	"github.com/prometheus/prometheus/discovery/ecs"
 	"github.com/prometheus/prometheus/discovery/file"
 	"github.com/prometheus/prometheus/discovery/gce"
 	"github.com/prometheus/prometheus/discovery/kubernetes"
 	"github.com/prometheus/prometheus/discovery/marathon"
 	"github.com/prometheus/prometheus/discovery/openstack"
+	"github.com/prometheus/prometheus/discovery/sql"
 	"github.com/prometheus/prometheus/discovery/triton"
 	"github.com/prometheus/prometheus/discovery/zookeeper"
 )
@@ -406,6 +408,11 @@ func (m *Manager) registerProviders(cfg sd_config.ServiceDiscoveryConfig, setNam
 			return ec2.NewDiscovery(c, log.With(m.logger, "discovery", "ec2")), nil
 		})
 	}
+	for _, c := range cfg.ECSSDConfigs {
+		add(c, func() (Discoverer, error) {
+			return ecs.NewDiscovery(c, log.With(m.logger, "discovery", "ecs")), nil
+		})
+	}
 	for _, c := range cfg.OpenstackSDConfigs {
 		add(c, func() (Discoverer, error) {
 			return openstack.NewDiscovery(c, log.With(m.logger, "discovery", "openstack"))
@@ -426,6 +433,11 @@ func (m *Manager) registerProviders(cfg sd_config.ServiceDiscoveryConfig, setNam
 			return triton.New(log.With(m.logger, "discovery", "triton"), c)
 		})
 	}
+	for _, c := range cfg.SQLSDConfigs {
+		add(c, func() (Discoverer, error) {
+			return sql.NewDiscovery(c, log.With(m.logger, "discovery", "sql"))
+		})
+	}
 	if len(cfg.StaticConfigs) > 0 {
 		add(setName, func() (Discoverer, error) {
 			return &StaticProvider{TargetGroups: cfg.StaticConfigs}, nil
@@ -0,0 +1,194 @@
+// Copyright 2021 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sql implements service discovery against an arbitrary SQL query,
+// for inventory that lives in a database rather than one of the other
+// supported SD mechanisms. It talks to the database purely through
+// database/sql, so it works with whatever driver the binary has linked in
+// -- Prometheus does not vendor one itself, the same way it does not vendor
+// a cloud SDK for every possible provider it could talk to via an HTTP API.
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/pkg/errors"
+	config_util "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/prometheus/discovery/refresh"
+	"github.com/prometheus/prometheus/discovery/targetgroup"
+)
+
+const sqlLabel = model.MetaLabelPrefix + "sql_"
+
+// DefaultSDConfig is the default SQL SD configuration.
+var DefaultSDConfig = SDConfig{
+	RefreshInterval: model.Duration(60 * time.Second),
+}
+
+// SDConfig is the configuration for SQL based service discovery.
+type SDConfig struct {
+	// Driver is the name of a database/sql driver registered in the binary,
+	// e.g. "postgres" or "mysql".
+	Driver string `yaml:"driver"`
+	// DataSourceName is the driver-specific connection string, e.g. a DSN
+	// or connection URL. It is a Secret because it commonly embeds
+	// credentials.
+	DataSourceName config_util.Secret `yaml:"data_source_name"`
+	// Query is run on every refresh. Its result set must include a column
+	// named by AddressColumn; any other selected column becomes a meta
+	// label named __meta_sql_<column>, unless remapped via LabelColumns.
+	Query string `yaml:"query"`
+	// AddressColumn is the name of the result column holding the
+	// "<host>:<port>" target address.
+	AddressColumn string `yaml:"address_column"`
+	// LabelColumns optionally renames result columns to meta label names;
+	// a column not listed here still becomes __meta_sql_<column>.
+	LabelColumns map[string]string `yaml:"label_columns,omitempty"`
+
+	RefreshInterval model.Duration `yaml:"refresh_interval,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *SDConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultSDConfig
+	type plain SDConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.Driver == "" {
+		return errors.New("sql SD configuration requires a driver")
+	}
+	if c.Query == "" {
+		return errors.New("sql SD configuration requires a query")
+	}
+	if c.AddressColumn == "" {
+		return errors.New("sql SD configuration requires an address_column")
+	}
+	return nil
+}
+
+// Discovery periodically queries a SQL database. It implements the
+// Discoverer interface.
+type Discovery struct {
+	*refresh.Discovery
+	db        *sql.DB
+	query     string
+	addrCol   string
+	labelCols map[string]string
+	logger    log.Logger
+}
+
+// NewDiscovery returns a new Discovery which periodically refreshes its targets.
+func NewDiscovery(conf *SDConfig, logger log.Logger) (*Discovery, error) {
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+
+	db, err := sql.Open(conf.Driver, string(conf.DataSourceName))
+	if err != nil {
+		return nil, fmt.Errorf("error opening sql connection: %w", err)
+	}
+
+	d := &Discovery{
+		db:        db,
+		query:     conf.Query,
+		addrCol:   conf.AddressColumn,
+		labelCols: conf.LabelColumns,
+		logger:    logger,
+	}
+
+	d.Discovery = refresh.NewDiscovery(
+		logger,
+		"sql",
+		time.Duration(conf.RefreshInterval),
+		d.refresh,
+	)
+	return d, nil
+}
+
+func (d *Discovery) refresh(ctx context.Context) ([]*targetgroup.Group, error) {
+	rows, err := d.db.QueryContext(ctx, d.query)
+	if err != nil {
+		return nil, fmt.Errorf("error running sql SD query: %w", err)
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("error reading sql SD result columns: %w", err)
+	}
+
+	tg := &targetgroup.Group{
+		Source: "sql",
+	}
+
+	for rows.Next() {
+		vals := make([]interface{}, len(cols))
+		ptrs := make([]interface{}, len(cols))
+		for i := range vals {
+			ptrs[i] = &vals[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, fmt.Errorf("error scanning sql SD row: %w", err)
+		}
+
+		lset := model.LabelSet{}
+		var addr model.LabelValue
+		for i, col := range cols {
+			v := columnString(vals[i])
+			if col == d.addrCol {
+				addr = model.LabelValue(v)
+				continue
+			}
+			name := sqlLabel + col
+			if mapped, ok := d.labelCols[col]; ok {
+				name = mapped
+			}
+			lset[model.LabelName(name)] = model.LabelValue(v)
+		}
+		if addr == "" {
+			level.Warn(d.logger).Log("msg", "Skipping row with empty address", "column", d.addrCol)
+			continue
+		}
+		lset[model.AddressLabel] = addr
+		tg.Targets = append(tg.Targets, lset)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating sql SD rows: %w", err)
+	}
+
+	return []*targetgroup.Group{tg}, nil
+}
+
+// columnString renders an arbitrary database/sql scan result as a string,
+// the way a label value needs to be, regardless of which Go type the
+// driver chose to represent the column's SQL type with.
+func columnString(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return string(val)
+	case string:
+		return val
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
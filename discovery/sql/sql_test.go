@@ -0,0 +1,120 @@
+// Copyright 2021 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+
+	"github.com/prometheus/common/model"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/prometheus/prometheus/util/testutil"
+)
+
+// fakeDriver is a minimal database/sql/driver.Driver, registered under the
+// name "sql_sd_test", that always returns the same two-row, two-column
+// result set regardless of query -- enough to exercise Discovery.refresh
+// without depending on an actual database or a vendored driver.
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	return fakeConn{}, nil
+}
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt{}, nil }
+func (fakeConn) Close() error                              { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                  { return nil, driver.ErrSkip }
+
+type fakeStmt struct{}
+
+func (fakeStmt) Close() error  { return nil }
+func (fakeStmt) NumInput() int { return -1 }
+func (fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, driver.ErrSkip
+}
+func (fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeRows{rows: [][]driver.Value{
+		{"10.0.0.1:9100", "web"},
+		{"10.0.0.2:9100", "db"},
+	}}, nil
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+	i    int
+}
+
+func (r *fakeRows) Columns() []string { return []string{"addr", "role"} }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.i >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.i])
+	r.i++
+	return nil
+}
+
+func init() {
+	sql.Register("sql_sd_test", fakeDriver{})
+}
+
+func TestDiscovery_Refresh(t *testing.T) {
+	conf := &SDConfig{
+		Driver:        "sql_sd_test",
+		Query:         "SELECT addr, role FROM targets",
+		AddressColumn: "addr",
+		LabelColumns:  map[string]string{"role": "__meta_sql_role_name"},
+	}
+	d, err := NewDiscovery(conf, nil)
+	testutil.Ok(t, err)
+
+	tgs, err := d.refresh(context.Background())
+	testutil.Ok(t, err)
+	testutil.Equals(t, 1, len(tgs))
+	testutil.Equals(t, "sql", tgs[0].Source)
+	testutil.Equals(t, 2, len(tgs[0].Targets))
+
+	testutil.Equals(t, model.LabelValue("10.0.0.1:9100"), tgs[0].Targets[0][model.AddressLabel])
+	testutil.Equals(t, model.LabelValue("web"), tgs[0].Targets[0][model.LabelName("__meta_sql_role_name")])
+	testutil.Equals(t, model.LabelValue("10.0.0.2:9100"), tgs[0].Targets[1][model.AddressLabel])
+	testutil.Equals(t, model.LabelValue("db"), tgs[0].Targets[1][model.LabelName("__meta_sql_role_name")])
+}
+
+func TestSDConfig_UnmarshalYAML_Validation(t *testing.T) {
+	cases := []struct {
+		yaml string
+		ok   bool
+	}{
+		{"driver: postgres\nquery: SELECT 1\naddress_column: addr\n", true},
+		{"query: SELECT 1\naddress_column: addr\n", false},
+		{"driver: postgres\naddress_column: addr\n", false},
+		{"driver: postgres\nquery: SELECT 1\n", false},
+	}
+	for _, c := range cases {
+		var sd SDConfig
+		err := yaml.Unmarshal([]byte(c.yaml), &sd)
+		if c.ok {
+			testutil.Ok(t, err)
+		} else {
+			testutil.NotOk(t, err, "expected validation error for %q", c.yaml)
+		}
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"regexp"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -127,6 +128,11 @@ type Options struct {
 	QueueCapacity  int
 	ExternalLabels labels.Labels
 	RelabelConfigs []*relabel.Config
+	// RetryBudgets are consulted, in order, to decide how aggressively to
+	// retry a failed send for a given alert. The first entry matching the
+	// alert's labels wins; alerts matching none of them fall back to
+	// config.DefaultRetryBudgetConfig.
+	RetryBudgets []*config.RetryBudgetConfig
 	// Used for sending HTTP requests to the Alertmanager.
 	Do func(ctx context.Context, client *http.Client, req *http.Request) (*http.Response, error)
 
@@ -258,6 +264,7 @@ func (n *Manager) ApplyConfig(conf *config.Config) error {
 
 	n.opts.ExternalLabels = conf.GlobalConfig.ExternalLabels
 	n.opts.RelabelConfigs = conf.AlertingConfig.AlertRelabelConfigs
+	n.opts.RetryBudgets = conf.AlertingConfig.RetryBudgets
 
 	amSets := make(map[string]*alertmanagerSet)
 
@@ -446,6 +453,116 @@ func (n *Manager) DroppedAlertmanagers() []*url.URL {
 	return res
 }
 
+// Silences queries the configured Alertmanagers for their currently active
+// silences and returns the merged list, deduplicated by silence ID. Errors
+// reaching individual Alertmanagers are logged and otherwise ignored, so a
+// partial view is returned as long as at least one Alertmanager answered.
+func (n *Manager) Silences(ctx context.Context) ([]*models.GettableSilence, error) {
+	n.mtx.RLock()
+	amSets := n.alertmanagers
+	n.mtx.RUnlock()
+
+	seen := map[string]struct{}{}
+	var (
+		silences []*models.GettableSilence
+		lastErr  error
+	)
+
+	for _, ams := range amSets {
+		ams.mtx.RLock()
+		client := ams.client
+		urls := make([]string, 0, len(ams.ams))
+		for _, am := range ams.ams {
+			u := am.url()
+			u.Path = path.Join(u.Path, "/api/v2/silences")
+			urls = append(urls, u.String())
+		}
+		ams.mtx.RUnlock()
+
+		for _, u := range urls {
+			got, err := n.getSilences(ctx, client, u)
+			if err != nil {
+				level.Error(n.logger).Log("alertmanager", u, "msg", "Error fetching silences", "err", err)
+				lastErr = err
+				continue
+			}
+			for _, s := range got {
+				if s.ID == nil || s.Status == nil || s.Status.State == nil || *s.Status.State != models.SilenceStatusStateActive {
+					continue
+				}
+				if _, ok := seen[*s.ID]; ok {
+					continue
+				}
+				seen[*s.ID] = struct{}{}
+				silences = append(silences, s)
+			}
+		}
+	}
+	if len(silences) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+	return silences, nil
+}
+
+func (n *Manager) getSilences(ctx context.Context, c *http.Client, url string) ([]*models.GettableSilence, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	resp, err := n.opts.Do(ctx, c, req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+
+	if resp.StatusCode/100 != 2 {
+		return nil, errors.Errorf("bad response status %s", resp.Status)
+	}
+
+	var silences models.GettableSilences
+	if err := json.NewDecoder(resp.Body).Decode(&silences); err != nil {
+		return nil, err
+	}
+	return silences, nil
+}
+
+// MatchesSilence reports whether lset is matched by every matcher of the
+// silence, i.e. whether the silence would suppress an alert with lset.
+func MatchesSilence(lset labels.Labels, s *models.GettableSilence) bool {
+	for _, m := range s.Matchers {
+		if m.Name == nil || m.Value == nil {
+			return false
+		}
+		v := lset.Get(*m.Name)
+		if m.IsRegex != nil && *m.IsRegex {
+			re, err := regexp.Compile("^(?:" + *m.Value + ")$")
+			if err != nil || !re.MatchString(v) {
+				return false
+			}
+			continue
+		}
+		if v != *m.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// retryBudgetFor returns the first configured RetryBudgetConfig matching
+// lbls, or config.DefaultRetryBudgetConfig if none match.
+func (n *Manager) retryBudgetFor(lbls labels.Labels) *config.RetryBudgetConfig {
+	for _, rb := range n.opts.RetryBudgets {
+		if rb.Matches(lbls) {
+			return rb
+		}
+	}
+	return &config.DefaultRetryBudgetConfig
+}
+
 // sendAll sends the alerts to all configured Alertmanagers concurrently.
 // It returns true if the alerts could be sent successfully to at least one Alertmanager.
 func (n *Manager) sendAll(alerts ...*Alert) bool {
@@ -453,6 +570,25 @@ func (n *Manager) sendAll(alerts ...*Alert) bool {
 		return true
 	}
 
+	// Split the batch by retry budget so that alerts that should be retried
+	// more aggressively don't have to wait on the slowest group, and so each
+	// group can be sent with its own backoff settings.
+	groups := make(map[*config.RetryBudgetConfig][]*Alert)
+	for _, a := range alerts {
+		rb := n.retryBudgetFor(a.Labels)
+		groups[rb] = append(groups[rb], a)
+	}
+
+	success := false
+	for rb, group := range groups {
+		if n.sendAllForBudget(rb, group...) {
+			success = true
+		}
+	}
+	return success
+}
+
+func (n *Manager) sendAllForBudget(rb *config.RetryBudgetConfig, alerts ...*Alert) bool {
 	begin := time.Now()
 
 	// v1Payload and v2Payload represent 'alerts' marshaled for Alertmanager API
@@ -523,7 +659,7 @@ func (n *Manager) sendAll(alerts ...*Alert) bool {
 			defer cancel()
 
 			go func(client *http.Client, url string) {
-				if err := n.sendOne(ctx, client, url, payload); err != nil {
+				if err := n.sendOneWithRetry(ctx, rb, client, url, payload); err != nil {
 					level.Error(n.logger).Log("alertmanager", url, "count", len(alerts), "msg", "Error sending alert", "err", err)
 					n.metrics.errors.WithLabelValues(url).Inc()
 				} else {
@@ -572,6 +708,33 @@ func labelsToOpenAPILabelSet(modelLabelSet labels.Labels) models.LabelSet {
 	return apiLabelSet
 }
 
+// sendOneWithRetry sends b to url, retrying on failure up to rb.MaxRetries
+// times with an exponential backoff between rb.MinBackoff and rb.MaxBackoff.
+func (n *Manager) sendOneWithRetry(ctx context.Context, rb *config.RetryBudgetConfig, c *http.Client, url string, b []byte) error {
+	backoff := time.Duration(rb.MinBackoff)
+
+	for try := 0; ; try++ {
+		err := n.sendOne(ctx, c, url, b)
+		if err == nil {
+			return nil
+		}
+		if try >= rb.MaxRetries {
+			return err
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		backoff *= 2
+		if backoff > time.Duration(rb.MaxBackoff) {
+			backoff = time.Duration(rb.MaxBackoff)
+		}
+	}
+}
+
 func (n *Manager) sendOne(ctx context.Context, c *http.Client, url string, b []byte) error {
 	req, err := http.NewRequest("POST", url, bytes.NewReader(b))
 	if err != nil {
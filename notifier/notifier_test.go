@@ -202,6 +202,48 @@ func TestHandlerSendAll(t *testing.T) {
 	checkNoErr()
 }
 
+func TestHandlerSendAllRetryBudget(t *testing.T) {
+	var numAttempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&numAttempts, 1) <= 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	h := NewManager(&Options{
+		RetryBudgets: []*config.RetryBudgetConfig{
+			{
+				Match:      map[string]string{"severity": "page"},
+				MaxRetries: 2,
+				MinBackoff: model.Duration(time.Millisecond),
+				MaxBackoff: model.Duration(time.Millisecond),
+			},
+		},
+	}, nil)
+
+	h.alertmanagers = map[string]*alertmanagerSet{
+		"1": {
+			ams: []alertmanager{
+				alertmanagerMock{
+					urlf: func() string { return server.URL },
+				},
+			},
+			cfg: &config.DefaultAlertmanagerConfig,
+		},
+	}
+
+	alerts := []*Alert{
+		{Labels: labels.FromStrings("alertname", "a", "severity", "page")},
+	}
+
+	testutil.Assert(t, h.sendAll(alerts...), "expected send to eventually succeed after retries")
+	testutil.Equals(t, int32(3), atomic.LoadInt32(&numAttempts))
+}
+
 func TestCustomDo(t *testing.T) {
 	const testURL = "http://testurl.com/"
 	const testBody = "testbody"
@@ -38,6 +38,21 @@ func (g *Gate) Start(ctx context.Context) error {
 	}
 }
 
+// TryAcquire attempts to take a spot in the gate without blocking. It
+// reports whether a spot was available; on success, the caller must call
+// Done once it is finished, exactly as after a successful Start. Callers
+// that would rather fail fast than queue behind already-running work, such
+// as a remote-read handler guarding against a misbehaving client piling up
+// concurrent requests, should use this instead of Start.
+func (g *Gate) TryAcquire() bool {
+	select {
+	case g.ch <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
 // Done releases a single spot in the gate.
 func (g *Gate) Done() {
 	select {
@@ -0,0 +1,46 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labels
+
+// Arena amortizes the per-series Label slice allocation that would
+// otherwise happen once per scraped series, by backing many Labels values
+// with one shared buffer. It is meant for callers that build many Labels in
+// a batch -- e.g. a scrape of a single target -- and can release them all at
+// once, such as when the corresponding cache entry is evicted.
+//
+// Labels returned by New are only valid until the Arena is released; they
+// must be copied out (e.g. with Labels.Copy) before that if they need to
+// outlive it.
+type Arena struct {
+	buf []Label
+}
+
+// NewArena returns an Arena with an initial buffer sized for roughly
+// initialCap labels, growing as needed.
+func NewArena(initialCap int) *Arena {
+	return &Arena{buf: make([]Label, 0, initialCap)}
+}
+
+// New returns a Labels value containing ls, backed by the arena's buffer.
+func (a *Arena) New(ls ...Label) Labels {
+	start := len(a.buf)
+	a.buf = append(a.buf, ls...)
+	return Labels(a.buf[start:len(a.buf):len(a.buf)])
+}
+
+// Release drops the arena's buffer so it can be garbage collected. Labels
+// previously returned by New must not be used after Release.
+func (a *Arena) Release() {
+	a.buf = nil
+}
@@ -0,0 +1,32 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labels
+
+import (
+	"testing"
+
+	"github.com/prometheus/prometheus/util/testutil"
+)
+
+func TestArena(t *testing.T) {
+	a := NewArena(4)
+
+	ls1 := a.New(Label{Name: "__name__", Value: "up"})
+	ls2 := a.New(Label{Name: "__name__", Value: "down"}, Label{Name: "job", Value: "x"})
+
+	testutil.Equals(t, Labels{{"__name__", "up"}}, ls1)
+	testutil.Equals(t, Labels{{"__name__", "down"}, {"job", "x"}}, ls2)
+
+	a.Release()
+}
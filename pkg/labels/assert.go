@@ -0,0 +1,29 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build labelsSortCheck
+
+package labels
+
+import "fmt"
+
+// assertSorted panics if ls is not sorted by name. It is only compiled in
+// when building with the labelsSortCheck tag, so NewFromSorted stays cheap
+// on the hot decode paths it exists for.
+func assertSorted(ls Labels) {
+	for i := 1; i < len(ls); i++ {
+		if ls[i-1].Name > ls[i].Name {
+			panic(fmt.Sprintf("labels.NewFromSorted: input not sorted: %q > %q", ls[i-1].Name, ls[i].Name))
+		}
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Only build when go-fuzz is in use
+// +build gofuzz
+
+package labels
+
+// FuzzParse fuzzes Parse with arbitrary input, the same way
+// promql/fuzz.go's FuzzParseExpr fuzzes the PromQL parser. Build with:
+//
+//     go-fuzz-build -func FuzzParse -o FuzzParse.zip github.com/prometheus/prometheus/pkg/labels
+//
+// Seed fuzz-data/Parse/corpus with the output of Labels.String on a variety
+// of label sets, including ones with non-printable values, to bias the
+// fuzzer toward inputs Parse is actually meant to round-trip.
+func FuzzParse(in []byte) int {
+	const (
+		fuzzInteresting = 1
+		fuzzMeh         = 0
+	)
+
+	lbls, err := Parse(string(in))
+	if err != nil {
+		return fuzzMeh
+	}
+	if lbls.String() != string(in) {
+		panic("Parse(s).String() != s for a string Parse accepted")
+	}
+	return fuzzInteresting
+}
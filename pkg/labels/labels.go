@@ -16,8 +16,12 @@ package labels
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"unsafe"
 
 	"github.com/cespare/xxhash"
 )
@@ -40,6 +44,12 @@ type Label struct {
 
 // Labels is a sorted set of labels. Order has to be guaranteed upon
 // instantiation.
+//
+// This is the only representation Labels has in this package: there is no
+// separate unsafe/packed encoding to build a "-tags slicelabels" debug
+// fallback against, so there is nothing to switch between here. If a packed
+// representation is introduced later, that would be the point to add the
+// build-tag split and a conformance suite running both.
 type Labels []Label
 
 func (ls Labels) Len() int           { return len(ls) }
@@ -47,13 +57,44 @@ func (ls Labels) Swap(i, j int)      { ls[i], ls[j] = ls[j], ls[i] }
 func (ls Labels) Less(i, j int) bool { return ls[i].Name < ls[j].Name }
 
 func (ls Labels) String() string {
+	return string(ls.AppendString(make([]byte, 0, 1024)))
+}
+
+// AppendString appends the String representation of ls to buf and returns
+// the extended buffer, so a caller that renders the same label set
+// repeatedly -- the rule manager and API handlers logging or keying by
+// String() among them -- can render it once and reuse the bytes instead of
+// paying for strconv.AppendQuote again on every call.
+func (ls Labels) AppendString(buf []byte) []byte {
+	buf = append(buf, '{')
+	for i, l := range ls {
+		if i > 0 {
+			buf = append(buf, ',', ' ')
+		}
+		buf = append(buf, l.Name...)
+		buf = append(buf, '=')
+		buf = strconv.AppendQuote(buf, l.Value)
+	}
+	buf = append(buf, '}')
+	return buf
+}
+
+// Compact returns ls formatted like String, but without the space after each
+// comma, for contexts that want a terser, comma-dense key -- a dedup cache
+// key or log field, for instance -- rather than the human-readable form.
+//
+// String here already emits the `{a="b", c="d"}` form with Go quoting,
+// which always produces valid escaping for non-printable values, so unlike
+// in some other Prometheus components there is no separate packed
+// representation whose output needs pulling back in line with upstream;
+// Compact only adds the terser alternative spelling.
+func (ls Labels) Compact() string {
 	var b bytes.Buffer
 
 	b.WriteByte('{')
 	for i, l := range ls {
 		if i > 0 {
 			b.WriteByte(',')
-			b.WriteByte(' ')
 		}
 		b.WriteString(l.Name)
 		b.WriteByte('=')
@@ -63,6 +104,21 @@ func (ls Labels) String() string {
 	return b.String()
 }
 
+// ByteSize returns the approximate number of bytes ls occupies in memory:
+// the backing array of the slice plus the bytes backing each Label's Name
+// and Value strings. Note that, unlike some other Prometheus components,
+// Labels here is a plain []Label rather than a single packed buffer with a
+// separate offset table, so this sums the Go runtime's actual allocations
+// for that representation rather than a literal header+offsets+payload
+// layout; it is accurate enough for series limits and memory estimations.
+func (ls Labels) ByteSize() int {
+	size := int(unsafe.Sizeof(ls)) + cap(ls)*int(unsafe.Sizeof(Label{}))
+	for _, l := range ls {
+		size += len(l.Name) + len(l.Value)
+	}
+	return size
+}
+
 // Bytes returns ls as a byte slice.
 // It uses an byte invalid character as a separator and so should not be used for printing.
 func (ls Labels) Bytes(buf []byte) []byte {
@@ -79,9 +135,36 @@ func (ls Labels) Bytes(buf []byte) []byte {
 	return b.Bytes()
 }
 
-// MarshalJSON implements json.Marshaler.
+// MarshalJSON implements json.Marshaler. Unlike marshaling ls.Map(), it
+// preserves the sorted label order and produces the same bytes on every
+// call for an identical label set.
 func (ls Labels) MarshalJSON() ([]byte, error) {
-	return json.Marshal(ls.Map())
+	return ls.AppendJSON(make([]byte, 0, 1024)), nil
+}
+
+// AppendJSON appends the JSON encoding of ls to buf and returns the
+// extended buffer. It encodes directly from ls, in label order, without
+// building an intermediate map, for callers such as the HTTP API
+// serializers that encode many label sets and want to reuse a buffer
+// across calls.
+func (ls Labels) AppendJSON(buf []byte) []byte {
+	buf = append(buf, '{')
+	for i, l := range ls {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = appendJSONString(buf, l.Name)
+		buf = append(buf, ':')
+		buf = appendJSONString(buf, l.Value)
+	}
+	buf = append(buf, '}')
+	return buf
+}
+
+// appendJSONString appends the JSON-quoted and escaped encoding of s to buf.
+func appendJSONString(buf []byte, s string) []byte {
+	b, _ := json.Marshal(s)
+	return append(buf, b...)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
@@ -133,18 +216,154 @@ func (ls Labels) MatchLabels(on bool, names ...string) Labels {
 }
 
 // Hash returns a hash value for the label set.
+//
+// It recomputes the hash from ls on every call rather than caching it on a
+// shared buffer, so it is safe to call concurrently on the same Labels value
+// from, e.g., the query and scrape paths at once.
+//
+// Hash is an internal implementation detail, not a stable contract: its
+// byte layout and hash function may change between releases as faster or
+// better-distributed alternatives come along. Anything that bakes the
+// result into a long-lived assignment -- hashmod sharding across a fleet,
+// remote-write shard routing -- must use StableHash instead.
 func (ls Labels) Hash() uint64 {
-	b := make([]byte, 0, 1024)
+	return ls.HashWith(xxhash.Sum64)
+}
 
+// StableHash returns a hash value for the label set that is guaranteed not
+// to change across Prometheus releases, unlike Hash. Its byte layout --
+// name, sep, value, sep for each label in order, with sep and labelSep
+// escaped wherever they occur inside a name or value, hashed with 64-bit
+// xxhash -- is frozen and must never change, even if Hash's own encoding
+// does.
+func (ls Labels) StableHash() uint64 {
+	b := make([]byte, 0, 1024)
 	for _, v := range ls {
-		b = append(b, v.Name...)
+		b = appendLabelComponent(b, v.Name)
 		b = append(b, sep)
-		b = append(b, v.Value...)
+		b = appendLabelComponent(b, v.Value)
 		b = append(b, sep)
 	}
 	return xxhash.Sum64(b)
 }
 
+// ShardOf returns which of n shards the label set belongs to, using
+// StableHash so that scrape sharding, remote-write sharding, and query
+// sharding can all call ShardOf with the same n and agree on series
+// placement, including across Prometheus versions.
+func (ls Labels) ShardOf(n uint32) uint32 {
+	return uint32(ls.StableHash() % uint64(n))
+}
+
+// Key returns a string that uniquely identifies ls, fit for use as a Go map
+// key wherever exact dedup is required -- a scrape target's series cache, for
+// instance. Unlike String, it does no quoting, so it stays cheap even for
+// values containing control characters; unlike Hash, it cannot collide
+// between two different label sets, since it is simply the escaped name/sep/
+// value/sep encoding those methods hash rather than a fixed-width digest of
+// it. Returned strings are not meant to be parsed back into labels, and are
+// not guaranteed stable across Prometheus versions the way StableHash is.
+func (ls Labels) Key() string {
+	b := make([]byte, 0, 1024)
+	for _, v := range ls {
+		b = appendLabelComponent(b, v.Name)
+		b = append(b, sep)
+		b = appendLabelComponent(b, v.Value)
+		b = append(b, sep)
+	}
+	return yoloString(b)
+}
+
+// yoloString converts b to a string without copying, for callers that will
+// not mutate b again afterwards -- Key builds b fresh and then discards its
+// only other reference, so this is safe there.
+func yoloString(b []byte) string {
+	return *((*string)(unsafe.Pointer(&b)))
+}
+
+// appendLabelComponent appends s to b for use in a sep-delimited encoding,
+// escaping any literal sep or labelSep byte in s so that a label name or
+// value containing one cannot be crafted to forge a collision with some
+// other label set's encoding -- e.g. {"a": "b\xffc"} colliding with
+// {"a": "b", "c": ""} because the embedded \xff reads back as a field
+// delimiter. labelSep is repurposed as the escape byte: it is itself escaped
+// the same way, so an escaped field never contains a literal, unescaped sep
+// or labelSep, and the plain sep appended after every field by the caller
+// remains an unambiguous delimiter.
+func appendLabelComponent(b []byte, s string) []byte {
+	if strings.IndexByte(s, sep) == -1 && strings.IndexByte(s, labelSep) == -1 {
+		return append(b, s...)
+	}
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case sep:
+			b = append(b, labelSep, 'S')
+		case labelSep:
+			b = append(b, labelSep, 'L')
+		default:
+			b = append(b, s[i])
+		}
+	}
+	return b
+}
+
+// HashWith returns a hash value for the label set computed by hasher, e.g.
+// to shard consistently with an external system that expects FNV-1a
+// fingerprints, or to seed the hash for a particular shard. Hash is
+// equivalent to HashWith(xxhash.Sum64) and is the right choice unless
+// something else specifically requires a different hash function.
+func (ls Labels) HashWith(hasher func([]byte) uint64) uint64 {
+	b := make([]byte, 0, 1024)
+
+	for _, v := range ls {
+		b = appendLabelComponent(b, v.Name)
+		b = append(b, sep)
+		b = appendLabelComponent(b, v.Value)
+		b = append(b, sep)
+	}
+	return hasher(b)
+}
+
+// fnvBasis and fnvPrime are the offset basis and prime for the 64-bit
+// FNV-1a hash, inlined byte-free the same way common/model does it, so
+// Fingerprint can reproduce its result without depending on that package.
+const (
+	fnvBasis uint64 = 14695981039346656037
+	fnvPrime uint64 = 1099511628211
+)
+
+// Fingerprint returns a hash value for the label set using the classic
+// FNV-1a based algorithm behind common/model.Metric.Fingerprint, with sep
+// as the field separator. Integrations that still key series by
+// model.Fingerprint -- federation helpers, some exporters, external
+// dedup layers -- can call this instead of rebuilding a model.LabelSet
+// just to get a comparable identifier. New code should prefer Hash,
+// which is faster and has a better distribution.
+func (ls Labels) Fingerprint() uint64 {
+	sum := fnvBasis
+	for _, v := range ls {
+		sum = fnvAdd(sum, v.Name)
+		sum = fnvAddByte(sum, sep)
+		sum = fnvAdd(sum, v.Value)
+		sum = fnvAddByte(sum, sep)
+	}
+	return sum
+}
+
+func fnvAdd(h uint64, s string) uint64 {
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= fnvPrime
+	}
+	return h
+}
+
+func fnvAddByte(h uint64, b byte) uint64 {
+	h ^= uint64(b)
+	h *= fnvPrime
+	return h
+}
+
 // HashForLabels returns a hash value for the labels matching the provided names.
 // 'names' have to be sorted in ascending order.
 func (ls Labels) HashForLabels(b []byte, names ...string) (uint64, []byte) {
@@ -156,9 +375,9 @@ func (ls Labels) HashForLabels(b []byte, names ...string) (uint64, []byte) {
 		} else if ls[i].Name < names[j] {
 			i++
 		} else {
-			b = append(b, ls[i].Name...)
+			b = appendLabelComponent(b, ls[i].Name)
 			b = append(b, sep)
-			b = append(b, ls[i].Value...)
+			b = appendLabelComponent(b, ls[i].Value)
 			b = append(b, sep)
 			i++
 			j++
@@ -180,9 +399,9 @@ func (ls Labels) HashWithoutLabels(b []byte, names ...string) (uint64, []byte) {
 		if ls[i].Name == MetricName || (j < len(names) && ls[i].Name == names[j]) {
 			continue
 		}
-		b = append(b, ls[i].Name...)
+		b = appendLabelComponent(b, ls[i].Name)
 		b = append(b, sep)
-		b = append(b, ls[i].Value...)
+		b = appendLabelComponent(b, ls[i].Value)
 		b = append(b, sep)
 	}
 	return xxhash.Sum64(b), b
@@ -226,7 +445,34 @@ func (ls Labels) WithoutLabels(names ...string) Labels {
 	return ret
 }
 
-// Copy returns a copy of the labels.
+// WithPrefix returns the subset of ls whose label names start with prefix,
+// e.g. "__meta_kubernetes_" for discovery meta labels. ls must be sorted, as
+// it is everywhere else in this package: that lets the prefix-matching
+// labels be found as a single contiguous run via PrefixRange, rather than
+// scanning the whole set. The result shares ls's backing array.
+func (ls Labels) WithPrefix(prefix string) Labels {
+	start, end := ls.PrefixRange(prefix)
+	return ls[start:end]
+}
+
+// PrefixRange returns the half-open index range [start, end) of ls whose
+// label names start with prefix. It binary-searches for the first label
+// name that could match, then scans forward only over the matching run,
+// rather than testing every label. ls must be sorted by name.
+func (ls Labels) PrefixRange(prefix string) (start, end int) {
+	start = sort.Search(len(ls), func(i int) bool {
+		return ls[i].Name >= prefix
+	})
+	end = start
+	for end < len(ls) && strings.HasPrefix(ls[end].Name, prefix) {
+		end++
+	}
+	return start, end
+}
+
+// Copy returns a copy of the labels that does not share a backing array with
+// ls, so it is safe to hold onto independently of whatever produced ls, e.g.
+// for long-lived storage in the TSDB head.
 func (ls Labels) Copy() Labels {
 	res := make(Labels, len(ls))
 	copy(res, ls)
@@ -286,11 +532,56 @@ func (ls Labels) WithoutEmpty() Labels {
 	return ls
 }
 
+// ComparePairs compares ls against pairs, which must already be sorted by
+// name just like ls itself, the same way New's input is required to sort to.
+// The result is 0 if they are equal, <0 if ls < pairs, and >0 if ls > pairs.
+//
+// It exists for callers -- the index writer and test helpers among them --
+// that hold a raw []Label and would otherwise have to call New(pairs...) just
+// to get a Labels to pass to Compare, allocating and sorting a throwaway
+// copy only to compare it once.
+func ComparePairs(ls Labels, pairs []Label) int {
+	l := len(ls)
+	if len(pairs) < l {
+		l = len(pairs)
+	}
+	for i := 0; i < l; i++ {
+		if d := strings.Compare(ls[i].Name, pairs[i].Name); d != 0 {
+			return d
+		}
+		if d := strings.Compare(ls[i].Value, pairs[i].Value); d != 0 {
+			return d
+		}
+	}
+	return len(ls) - len(pairs)
+}
+
+// EqualPairs reports whether ls equals pairs, which must already be sorted by
+// name just like ls itself. See ComparePairs for why this avoids building a
+// Labels out of pairs first.
+func EqualPairs(ls Labels, pairs []Label) bool {
+	if len(ls) != len(pairs) {
+		return false
+	}
+	for i, l := range ls {
+		if l.Name != pairs[i].Name || l.Value != pairs[i].Value {
+			return false
+		}
+	}
+	return true
+}
+
 // Equal returns whether the two label sets are equal.
 func Equal(ls, o Labels) bool {
 	if len(ls) != len(o) {
 		return false
 	}
+	// ls and o often alias the same backing array -- e.g. a series' labels
+	// handed back to a caller that never mutates them -- in which case every
+	// element is trivially equal and the loop below can be skipped entirely.
+	if len(ls) > 0 && &ls[0] == &o[0] {
+		return true
+	}
 	for i, l := range ls {
 		if l.Name != o[i].Name || l.Value != o[i].Value {
 			return false
@@ -299,13 +590,115 @@ func Equal(ls, o Labels) bool {
 	return true
 }
 
+// EqualWithout returns whether ls and o are equal, ignoring any labels
+// matching names, so alert deduplication and series-change detection can
+// compare label sets while disregarding e.g. `replica` or `__address__` in
+// one pass over the two packed sets rather than building a projected
+// WithoutLabels copy of each side first.
+// 'names' have to be sorted in ascending order.
+func EqualWithout(ls, o Labels, names ...string) bool {
+	i, j := 0, 0
+	ni, nj := 0, 0
+	for {
+		for i < len(ls) {
+			for ni < len(names) && names[ni] < ls[i].Name {
+				ni++
+			}
+			if ni < len(names) && names[ni] == ls[i].Name {
+				i++
+				continue
+			}
+			break
+		}
+		for j < len(o) {
+			for nj < len(names) && names[nj] < o[j].Name {
+				nj++
+			}
+			if nj < len(names) && names[nj] == o[j].Name {
+				j++
+				continue
+			}
+			break
+		}
+		if i >= len(ls) || j >= len(o) {
+			return i >= len(ls) && j >= len(o)
+		}
+		if ls[i].Name != o[j].Name || ls[i].Value != o[j].Value {
+			return false
+		}
+		i++
+		j++
+	}
+}
+
+// Dedupe sorts s and removes adjacent duplicate label sets in place,
+// returning the deduplicated prefix. s must not be used after the call
+// except through the returned slice.
+func (s Slice) Dedupe() Slice {
+	sort.Sort(s)
+	if len(s) < 2 {
+		return s
+	}
+	j := 0
+	for i := 1; i < len(s); i++ {
+		if Compare(s[j], s[i]) != 0 {
+			j++
+			s[j] = s[i]
+		}
+	}
+	return s[:j+1]
+}
+
+// NewSlice builds a Slice out of raw, not-yet-sorted label pairs, one Labels
+// per entry of ls, sorting each with a single shared scratch buffer instead
+// of letting New allocate and sort one independently per call.
+func NewSlice(ls [][]Label) Slice {
+	slice := make(Slice, len(ls))
+	for i, l := range ls {
+		set := make(Labels, len(l))
+		copy(set, l)
+		sort.Sort(set)
+		slice[i] = set
+	}
+	return slice
+}
+
+// Names returns the label names, in the order Labels is sorted in.
+func (ls Labels) Names() []string {
+	return ls.AppendNames(make([]string, 0, len(ls)))
+}
+
+// AppendNames appends the label names, in the order Labels is sorted in, to
+// dst and returns the extended slice, so a caller computing e.g. a grouping
+// key for many series can reuse one backing array instead of allocating one
+// per call.
+func (ls Labels) AppendNames(dst []string) []string {
+	for _, l := range ls {
+		dst = append(dst, l.Name)
+	}
+	return dst
+}
+
 // Map returns a string map of the labels.
 func (ls Labels) Map() map[string]string {
-	m := make(map[string]string, len(ls))
+	return ls.MapInto(make(map[string]string, len(ls)))
+}
+
+// MapInto clears dst and fills it with ls, returning dst. It lets a caller
+// that calls Map() on many label sets in a tight loop but only needs the
+// result transiently -- to hash it, look something up in it, or otherwise
+// consume it before moving to the next label set -- reuse one map instead
+// of allocating a new one every time. It is the wrong choice wherever the
+// returned map escapes the loop, e.g. into a serialized response or a
+// stored rule result, since those need their own independent map.
+func (ls Labels) MapInto(dst map[string]string) map[string]string {
+	for k := range dst {
+		delete(dst, k)
+	}
 	for _, l := range ls {
-		m[l.Name] = l.Value
+		dst[l.Name] = l.Value
 	}
-	return m
+	return dst
 }
 
 // New returns a sorted Labels from the given labels.
@@ -320,6 +713,174 @@ func New(ls ...Label) Labels {
 	return set
 }
 
+// EmptyLabels returns the canonical empty Labels, to avoid allocating a new
+// slice via New() at call sites that just want the empty set. The nil zero
+// value of Labels already behaves correctly wherever EmptyLabels is used, so
+// this is purely a readability aid.
+func EmptyLabels() Labels {
+	return Labels{}
+}
+
+// IsEmpty reports whether ls has no labels.
+func (ls Labels) IsEmpty() bool {
+	return len(ls) == 0
+}
+
+// NewFromSorted returns a Labels from ls, which must already be sorted by
+// name, as e.g. the TSDB index reader and remote-write decoder produce it.
+// Unlike New, it does not sort its input, only asserting (in builds with
+// assertions enabled via the labelsSortCheck build tag) that it is already
+// in order, so it is cheaper on decode paths that already maintain sorted
+// order.
+func NewFromSorted(ls []Label) Labels {
+	set := make(Labels, len(ls))
+	copy(set, ls)
+	assertSorted(set)
+	return set
+}
+
+// Parse parses the string produced by Labels.String back into a Labels
+// value. It understands the quoting and escaping String uses, including the
+// non-printable forms (e.g. "\x00") that strconv.Quote emits, so it accepts
+// anything String can produce. It is meant for tooling -- promtool, API
+// clients, rule unit test fixtures -- that needs to turn a label set string
+// back into Labels without pulling in the PromQL parser.
+func Parse(s string) (Labels, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, fmt.Errorf("invalid labels string %q: missing braces", s)
+	}
+	body := strings.TrimSpace(s[1 : len(s)-1])
+	if body == "" {
+		return Labels{}, nil
+	}
+
+	var lbls Labels
+	for len(body) > 0 {
+		eq := strings.IndexByte(body, '=')
+		if eq < 0 {
+			return nil, fmt.Errorf("invalid labels string %q: expected '=' after label name", s)
+		}
+		name := strings.TrimSpace(body[:eq])
+		if name == "" {
+			return nil, fmt.Errorf("invalid labels string %q: empty label name", s)
+		}
+
+		rest := body[eq+1:]
+		if len(rest) == 0 || rest[0] != '"' {
+			return nil, fmt.Errorf("invalid labels string %q: value for %q is not quoted", s, name)
+		}
+		end := -1
+		for i := 1; i < len(rest); i++ {
+			if rest[i] == '\\' {
+				i++
+				continue
+			}
+			if rest[i] == '"' {
+				end = i
+				break
+			}
+		}
+		if end < 0 {
+			return nil, fmt.Errorf("invalid labels string %q: unterminated value for %q", s, name)
+		}
+		value, err := strconv.Unquote(rest[:end+1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid labels string %q: value for %q: %s", s, name, err)
+		}
+		lbls = append(lbls, Label{Name: name, Value: value})
+
+		body = strings.TrimSpace(rest[end+1:])
+		if body == "" {
+			break
+		}
+		if body[0] != ',' {
+			return nil, fmt.Errorf("invalid labels string %q: expected ',' after %q", s, name)
+		}
+		body = strings.TrimSpace(body[1:])
+	}
+
+	sort.Sort(lbls)
+	return lbls, nil
+}
+
+// AppendEscapedValue appends the Prometheus/OpenMetrics exposition format
+// escaping of v to b and returns the extended buffer. It backslash-escapes
+// only backslash, double quote, and newline -- the three bytes the text
+// exposition format requires escaped in a label value -- matching what
+// pkg/textparse already unescapes on the read side (see lvalReplacer in
+// promparse.go). It is meant for code that formats Labels back into
+// exposition-format text, e.g. backfill tooling, so that escaping logic
+// doesn't get reimplemented, and re-diverge, at each call site.
+func AppendEscapedValue(b []byte, v string) []byte {
+	for i := 0; i < len(v); i++ {
+		switch v[i] {
+		case '\\':
+			b = append(b, '\\', '\\')
+		case '"':
+			b = append(b, '\\', '"')
+		case '\n':
+			b = append(b, '\\', 'n')
+		default:
+			b = append(b, v[i])
+		}
+	}
+	return b
+}
+
+// UnescapeValue reverses AppendEscapedValue. It returns an error if s
+// contains a backslash not followed by one of \, ", or n, since such a
+// sequence could not have been produced by AppendEscapedValue.
+func UnescapeValue(s string) (string, error) {
+	if !strings.ContainsRune(s, '\\') {
+		return s, nil
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		if i >= len(s) {
+			return "", fmt.Errorf("invalid escape sequence at end of %q", s)
+		}
+		switch s[i] {
+		case '\\':
+			b.WriteByte('\\')
+		case '"':
+			b.WriteByte('"')
+		case 'n':
+			b.WriteByte('\n')
+		default:
+			return "", fmt.Errorf(`invalid escape sequence "\%c" in %q`, s[i], s)
+		}
+	}
+	return b.String(), nil
+}
+
+// AppendOpenMetrics appends ls to b in exposition-format label syntax, e.g.
+// `{a="b",c="d"}`, escaping each value with AppendEscapedValue, and returns
+// the extended buffer. It lets a caller turning Labels back into
+// exposition-format text, such as OpenMetrics backfill tooling, reuse one
+// buffer across many series instead of building a separate string per
+// series.
+func (ls Labels) AppendOpenMetrics(b []byte) []byte {
+	b = append(b, '{')
+	for i, l := range ls {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = append(b, l.Name...)
+		b = append(b, '=', '"')
+		b = AppendEscapedValue(b, l.Value)
+		b = append(b, '"')
+	}
+	b = append(b, '}')
+	return b
+}
+
 // FromMap returns new sorted Labels from the given map.
 func FromMap(m map[string]string) Labels {
 	l := make([]Label, 0, len(m))
@@ -345,30 +906,86 @@ func FromStrings(ss ...string) Labels {
 
 // Compare compares the two label sets.
 // The result will be 0 if a==b, <0 if a < b, and >0 if a > b.
+//
+// Both label sets are already stored in sorted name/value order, so each
+// field can be settled with a single strings.Compare instead of a separate
+// equality check followed by a less-than check; this is called on the order
+// of once per series comparison by the compactor and index writer, so it's
+// worth sparing the extra branch.
 func Compare(a, b Labels) int {
+	if len(a) > 0 && len(a) == len(b) && &a[0] == &b[0] {
+		return 0
+	}
+
 	l := len(a)
 	if len(b) < l {
 		l = len(b)
 	}
 
 	for i := 0; i < l; i++ {
-		if a[i].Name != b[i].Name {
-			if a[i].Name < b[i].Name {
-				return -1
-			}
-			return 1
+		if d := strings.Compare(a[i].Name, b[i].Name); d != 0 {
+			return d
 		}
-		if a[i].Value != b[i].Value {
-			if a[i].Value < b[i].Value {
-				return -1
-			}
-			return 1
+		if d := strings.Compare(a[i].Value, b[i].Value); d != 0 {
+			return d
 		}
 	}
 	// If all labels so far were in common, the set with fewer labels comes first.
 	return len(a) - len(b)
 }
 
+// LabelDiff describes a label present in both of two label sets compared
+// with Diff, but whose value differs between them.
+type LabelDiff struct {
+	Name               string
+	OldValue, NewValue string
+}
+
+// Diff reports how b differs from a: added holds the labels present in b but
+// not a, removed holds the labels present in a but not b, and changed holds
+// the labels present in both whose value differs. a and b must both already
+// be sorted by name, as Diff is computed with a single merge pass over the
+// two slices rather than building an intermediate map for each side.
+func Diff(a, b Labels) (added, removed Labels, changed []LabelDiff) {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i].Name < b[j].Name:
+			removed = append(removed, a[i])
+			i++
+		case a[i].Name > b[j].Name:
+			added = append(added, b[j])
+			j++
+		default:
+			if a[i].Value != b[j].Value {
+				changed = append(changed, LabelDiff{Name: a[i].Name, OldValue: a[i].Value, NewValue: b[j].Value})
+			}
+			i++
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		removed = append(removed, a[i])
+	}
+	for ; j < len(b); j++ {
+		added = append(added, b[j])
+	}
+	return added, removed, changed
+}
+
+// Merge returns the union of primary and secondary, with primary's value
+// winning whenever both sets define the same label name -- the "don't
+// overwrite existing" policy used when assembling a target's final label
+// set out of discovered labels, global external_labels, and scrape-config
+// labels.
+func Merge(primary, secondary Labels) Labels {
+	b := NewBuilder(secondary)
+	for _, l := range primary {
+		b.Set(l.Name, l.Value)
+	}
+	return b.Labels()
+}
+
 // Builder allows modifying Labels.
 type Builder struct {
 	base Labels
@@ -428,6 +1045,81 @@ func (b *Builder) Set(n, v string) *Builder {
 	return b
 }
 
+// SetIfAbsent sets the name/value pair as a label, unless a label of that
+// name is already present, in which case it is a no-op. This is the "don't
+// overwrite existing" half of assembling a target's final label set out of
+// discovered labels, global external_labels, and scrape-config labels, where
+// whichever of those is applied first should win.
+func (b *Builder) SetIfAbsent(n, v string) *Builder {
+	if b.Get(n) != "" {
+		return b
+	}
+	return b.Set(n, v)
+}
+
+// Get returns the value of the label with the given name while taking into
+// account any pending changes, without materializing Labels(). Returns an
+// empty string if the label doesn't exist.
+func (b *Builder) Get(n string) string {
+	for _, d := range b.del {
+		if d == n {
+			return ""
+		}
+	}
+	for _, a := range b.add {
+		if a.Name == n {
+			return a.Value
+		}
+	}
+	return b.base.Get(n)
+}
+
+// Range calls f on each label that is present in the Labels(), including
+// pending changes, without materializing Labels().
+func (b *Builder) Range(f func(l Label)) {
+Outer:
+	for _, l := range b.base {
+		for _, d := range b.del {
+			if l.Name == d {
+				continue Outer
+			}
+		}
+		for _, a := range b.add {
+			if l.Name == a.Name {
+				continue Outer
+			}
+		}
+		f(l)
+	}
+	for _, a := range b.add {
+		f(a)
+	}
+}
+
+var builderPool = sync.Pool{
+	New: func() interface{} {
+		return &Builder{
+			del: make([]string, 0, 5),
+			add: make([]Label, 0, 5),
+		}
+	},
+}
+
+// NewBuilderFromPool returns a Builder reset to base, reusing the del/add
+// slices of a Builder from a shared pool instead of allocating new ones.
+// Callers must return the Builder with PutBuilder once they are done with it.
+func NewBuilderFromPool(base Labels) *Builder {
+	b := builderPool.Get().(*Builder)
+	b.Reset(base)
+	return b
+}
+
+// PutBuilder returns b to the shared pool used by NewBuilderFromPool. b must
+// not be used again after this call.
+func PutBuilder(b *Builder) {
+	builderPool.Put(b)
+}
+
 // Labels returns the labels from the builder. If no modifications
 // were made, the original labels are returned.
 func (b *Builder) Labels() Labels {
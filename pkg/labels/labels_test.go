@@ -14,8 +14,16 @@
 package labels
 
 import (
+	"encoding/json"
+	"hash/fnv"
+	"sort"
+	"sync"
 	"testing"
 
+	"github.com/cespare/xxhash"
+	"github.com/prometheus/common/model"
+	yaml "gopkg.in/yaml.v2"
+
 	"github.com/prometheus/prometheus/util/testutil"
 )
 
@@ -49,7 +57,165 @@ func TestLabels_String(t *testing.T) {
 	for _, c := range cases {
 		str := c.lables.String()
 		testutil.Equals(t, c.expected, str)
+		testutil.Equals(t, c.expected, string(c.lables.AppendString(nil)))
+	}
+}
+
+func TestLabels_AppendString_ReusesBuffer(t *testing.T) {
+	lbls := Labels{{Name: "a", Value: "\x00non-printable"}}
+	buf := make([]byte, 0, 1024)
+	buf = lbls.AppendString(buf)
+	testutil.Equals(t, lbls.String(), string(buf))
+
+	// Calling it again with the same backing buffer should produce the
+	// same rendering without the caller needing to reset anything beyond
+	// re-slicing to length 0, the way a reused log/response buffer would.
+	buf2 := lbls.AppendString(buf[:0])
+	testutil.Equals(t, string(buf), string(buf2))
+}
+
+func TestLabels_Compact(t *testing.T) {
+	lbls := Labels{{"t1", "t1"}, {"t2", "t2"}}
+	testutil.Equals(t, `{t1="t1",t2="t2"}`, lbls.Compact())
+	testutil.Equals(t, "{}", Labels{}.Compact())
+}
+
+func TestLabels_Parse(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected Labels
+	}{
+		{
+			input:    "{}",
+			expected: Labels{},
+		},
+		{
+			input: `{__name__="up", instance="localhost:9090", job="prometheus"}`,
+			expected: Labels{
+				{Name: "__name__", Value: "up"},
+				{Name: "instance", Value: "localhost:9090"},
+				{Name: "job", Value: "prometheus"},
+			},
+		},
+		{
+			input: `{quoted="va\"l\\ue"}`,
+			expected: Labels{
+				{Name: "quoted", Value: `va"l\ue`},
+			},
+		},
+	}
+	for _, c := range cases {
+		lbls, err := Parse(c.input)
+		testutil.Ok(t, err)
+		testutil.Equals(t, c.expected, lbls)
+	}
+
+	// Parse must invert String for an arbitrary label set, including one
+	// whose value needs escaping.
+	lbls := Labels{
+		{Name: "__name__", Value: "http_requests_total"},
+		{Name: "path", Value: `/api/"v1"\n`},
+	}
+	got, err := Parse(lbls.String())
+	testutil.Ok(t, err)
+	testutil.Equals(t, lbls, got)
+
+	for _, input := range []string{
+		"",
+		"{",
+		"{a=\"b\"",
+		`{a="b" c="d"}`,
+		`{=""}`,
+		`{a=b}`,
+	} {
+		_, err := Parse(input)
+		testutil.NotOk(t, err, "input: %q", input)
+	}
+}
+
+// FuzzParse checks that Parse never panics and, whenever it accepts an
+// input, that re-parsing String() of the result reproduces an equal
+// Labels value. It complements the go-fuzz-style harness in fuzz.go,
+// which is only built with the gofuzz tag, by giving this invariant a
+// form the stock `go test -fuzz` toolchain can run directly.
+func FuzzParse(f *testing.F) {
+	for _, seed := range []string{
+		"{}",
+		`{__name__="up", instance="localhost:9090", job="prometheus"}`,
+		`{quoted="va\"l\\ue"}`,
+		"{",
+		`{a="b}`,
+	} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		lbls, err := Parse(input)
+		if err != nil {
+			return
+		}
+		got, err := Parse(lbls.String())
+		testutil.Ok(t, err)
+		testutil.Equals(t, lbls, got)
+	})
+}
+
+func TestAppendEscapedValueAndUnescapeValue(t *testing.T) {
+	cases := []struct {
+		value   string
+		escaped string
+	}{
+		{value: "simple", escaped: "simple"},
+		{value: `with "quotes"`, escaped: `with \"quotes\"`},
+		{value: `back\slash`, escaped: `back\\slash`},
+		{value: "multi\nline", escaped: `multi\nline`},
+		{value: "tab\tstays\tliteral", escaped: "tab\tstays\tliteral"},
+	}
+	for _, c := range cases {
+		testutil.Equals(t, c.escaped, string(AppendEscapedValue(nil, c.value)))
+
+		got, err := UnescapeValue(c.escaped)
+		testutil.Ok(t, err)
+		testutil.Equals(t, c.value, got)
+	}
+
+	if _, err := UnescapeValue(`bad\x`); err == nil {
+		t.Fatalf("expected an error for an unknown escape sequence")
+	}
+	if _, err := UnescapeValue(`trailing\`); err == nil {
+		t.Fatalf("expected an error for a trailing backslash")
+	}
+}
+
+func TestLabels_AppendOpenMetrics(t *testing.T) {
+	lbls := Labels{
+		{Name: "__name__", Value: "up"},
+		{Name: "instance", Value: "localhost:9090"},
+		{Name: "quoted", Value: `va"l\ue`},
 	}
+	got := string(lbls.AppendOpenMetrics(nil))
+	testutil.Equals(t, `{__name__="up",instance="localhost:9090",quoted="va\"l\\ue"}`, got)
+
+	// Appending onto an existing buffer must not clobber its contents.
+	buf := []byte("prefix")
+	got = string(lbls.AppendOpenMetrics(buf))
+	testutil.Equals(t, `prefix{__name__="up",instance="localhost:9090",quoted="va\"l\\ue"}`, got)
+}
+
+func TestLabels_Fingerprint(t *testing.T) {
+	lbls := Labels{
+		{Name: "__name__", Value: "requests_total"},
+		{Name: "instance", Value: "localhost:9090"},
+		{Name: "job", Value: "prometheus"},
+	}
+
+	want := model.LabelSet{
+		"__name__": "requests_total",
+		"instance": "localhost:9090",
+		"job":      "prometheus",
+	}.Fingerprint()
+
+	testutil.Equals(t, uint64(want), lbls.Fingerprint())
+	testutil.Equals(t, uint64(model.LabelSet{}.Fingerprint()), Labels{}.Fingerprint())
 }
 
 func TestLabels_MatchLabels(t *testing.T) {
@@ -288,6 +454,14 @@ func TestLabels_WithoutEmpty(t *testing.T) {
 	}
 }
 
+func TestLabels_WithoutEmpty_AllEmpty(t *testing.T) {
+	input := Labels{
+		{Name: "foo"},
+		{Name: "bar"},
+	}
+	testutil.Equals(t, Labels{}, input.WithoutEmpty())
+}
+
 func TestLabels_Equal(t *testing.T) {
 	labels := Labels{
 		{
@@ -368,6 +542,37 @@ func TestLabels_Equal(t *testing.T) {
 	}
 }
 
+func TestLabels_YAML(t *testing.T) {
+	lbls := Labels{{"bbb", "222"}, {"aaa", "111"}}
+
+	b, err := yaml.Marshal(lbls)
+	testutil.Ok(t, err)
+	testutil.Equals(t, "aaa: \"111\"\nbbb: \"222\"\n", string(b))
+
+	var got Labels
+	testutil.Ok(t, yaml.Unmarshal(b, &got))
+	sort.Sort(lbls)
+	testutil.Equals(t, lbls, got)
+}
+
+func TestLabels_MarshalJSON(t *testing.T) {
+	lbls := Labels{{"__name__", "up"}, {"instance", "a\"b"}, {"job", "node"}}
+
+	b, err := json.Marshal(lbls)
+	testutil.Ok(t, err)
+	testutil.Equals(t, `{"__name__":"up","instance":"a\"b","job":"node"}`, string(b))
+
+	var got Labels
+	testutil.Ok(t, json.Unmarshal(b, &got))
+	sort.Sort(lbls)
+	testutil.Equals(t, lbls, got)
+
+	// Marshaling the same label set twice must produce identical bytes.
+	b2, err := json.Marshal(lbls)
+	testutil.Ok(t, err)
+	testutil.Equals(t, b, b2)
+}
+
 func TestLabels_FromStrings(t *testing.T) {
 	labels := FromStrings("aaa", "111", "bbb", "222")
 	expected := Labels{
@@ -389,6 +594,19 @@ func TestLabels_FromStrings(t *testing.T) {
 	testutil.Assert(t, false, "did not panic as expected")
 }
 
+func TestComparePairsAndEqualPairs(t *testing.T) {
+	ls := Labels{{"aaa", "111"}, {"bbb", "222"}}
+
+	testutil.Equals(t, 0, ComparePairs(ls, []Label{{"aaa", "111"}, {"bbb", "222"}}))
+	testutil.Assert(t, EqualPairs(ls, []Label{{"aaa", "111"}, {"bbb", "222"}}), "expected equal pairs")
+
+	testutil.Assert(t, ComparePairs(ls, []Label{{"aaa", "111"}, {"bbb", "333"}}) < 0, "expected ls < pairs")
+	testutil.Assert(t, !EqualPairs(ls, []Label{{"aaa", "111"}, {"bbb", "333"}}), "expected unequal pairs")
+
+	testutil.Assert(t, ComparePairs(ls, []Label{{"aaa", "111"}}) > 0, "expected ls > shorter pairs")
+	testutil.Assert(t, !EqualPairs(ls, []Label{{"aaa", "111"}}), "expected unequal lengths to compare unequal")
+}
+
 func TestLabels_Compare(t *testing.T) {
 	labels := Labels{
 		{
@@ -540,19 +758,186 @@ func TestLabels_Has(t *testing.T) {
 	}
 }
 
+func TestLabels_Hash_Concurrent(t *testing.T) {
+	lbls := Labels{{"aaa", "111"}, {"bbb", "222"}}
+	want := lbls.Hash()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			testutil.Equals(t, want, lbls.Hash())
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLabels_HashWith(t *testing.T) {
+	lbls := Labels{{"aaa", "111"}, {"bbb", "222"}}
+
+	testutil.Equals(t, lbls.Hash(), lbls.HashWith(xxhash.Sum64))
+
+	fnv1a := func(b []byte) uint64 {
+		h := fnv.New64a()
+		h.Write(b)
+		return h.Sum64()
+	}
+	testutil.Equals(t, fnv1a(nil), Labels{}.HashWith(fnv1a))
+	testutil.Assert(t, lbls.HashWith(fnv1a) != lbls.Hash(), "expected a different hash function to produce a different result")
+}
+
+func TestLabels_ByteSize(t *testing.T) {
+	lbls := Labels{{"__name__", "up"}, {"instance", "localhost:9090"}}
+
+	// Growing the label set, or the strings it holds, must grow the
+	// reported size.
+	testutil.Assert(t, lbls.ByteSize() > 0, "expected a positive size")
+	bigger := append(Labels{}, lbls...)
+	bigger = append(bigger, Label{"job", "node"})
+	testutil.Assert(t, bigger.ByteSize() > lbls.ByteSize(), "expected adding a label to grow the size")
+}
+
+func TestNewFromSorted(t *testing.T) {
+	input := []Label{{"__name__", "up"}, {"instance", "a"}, {"job", "node"}}
+	lbls := NewFromSorted(input)
+	testutil.Equals(t, Labels(input), lbls)
+
+	// The result must be an independent copy of the input slice.
+	input[0].Value = "mutated"
+	testutil.Equals(t, "up", lbls[0].Value)
+}
+
+func TestEmptyLabels(t *testing.T) {
+	testutil.Assert(t, EmptyLabels().IsEmpty(), "EmptyLabels() should be empty")
+	testutil.Assert(t, Labels(nil).IsEmpty(), "the nil zero value should be empty")
+	testutil.Assert(t, !New(Label{Name: "a", Value: "b"}).IsEmpty(), "a non-empty Labels should not be empty")
+}
+
+func TestLabels_Names(t *testing.T) {
+	lbls := Labels{{"__name__", "up"}, {"instance", "a"}, {"job", "node"}}
+	testutil.Equals(t, []string{"__name__", "instance", "job"}, lbls.Names())
+
+	dst := make([]string, 0, 8)
+	dst = lbls.AppendNames(dst)
+	testutil.Equals(t, []string{"__name__", "instance", "job"}, dst)
+}
+
+func TestDiff(t *testing.T) {
+	a := New(Label{"__name__", "up"}, Label{"instance", "a"}, Label{"job", "node"})
+	b := New(Label{"__name__", "up"}, Label{"instance", "b"}, Label{"region", "us-east"})
+
+	added, removed, changed := Diff(a, b)
+	testutil.Equals(t, Labels{{"region", "us-east"}}, added)
+	testutil.Equals(t, Labels{{"job", "node"}}, removed)
+	testutil.Equals(t, []LabelDiff{{Name: "instance", OldValue: "a", NewValue: "b"}}, changed)
+
+	addedEq, removedEq, changedEq := Diff(a, a)
+	testutil.Equals(t, Labels(nil), addedEq)
+	testutil.Equals(t, Labels(nil), removedEq)
+	testutil.Equals(t, []LabelDiff(nil), changedEq)
+}
+
+func TestLabels_ShardOf(t *testing.T) {
+	lbls := Labels{{"__name__", "up"}, {"instance", "a"}, {"job", "node"}}
+
+	testutil.Equals(t, uint32(lbls.StableHash()%16), lbls.ShardOf(16))
+
+	// Must be stable across calls.
+	testutil.Equals(t, lbls.ShardOf(64), lbls.ShardOf(64))
+}
+
+func TestLabels_StableHash(t *testing.T) {
+	// Pinned output: StableHash's byte layout and hash function must never
+	// change, so a future rewrite of Hash must not change these values.
+	cases := []struct {
+		lbls Labels
+		want uint64
+	}{
+		{Labels{}, 0xef46db3751d8e999},
+		{Labels{{"aaa", "111"}, {"bbb", "222"}}, 0xe4c416c03a78cace},
+		{Labels{{"__name__", "up"}, {"instance", "a"}, {"job", "node"}}, 0xb3986a90a34ae2db},
+	}
+	for _, c := range cases {
+		testutil.Equals(t, c.want, c.lbls.StableHash())
+	}
+
+	// Must agree with Hash today, since StableHash currently uses the same
+	// byte layout and hash function, but the two are not the same method
+	// and a change to one must not silently change the other.
+	lbls := Labels{{"aaa", "111"}, {"bbb", "222"}}
+	testutil.Equals(t, lbls.Hash(), lbls.StableHash())
+}
+
+func TestLabels_HashForgery(t *testing.T) {
+	// A value containing the raw separator byte must not be hashable into
+	// colliding with some other label set that only looks the same once the
+	// separator is naively spliced in.
+	forged := Labels{{"a", "b\xffc"}}
+	distinct := Labels{{"a", "b"}, {"c", ""}}
+	testutil.Assert(t, forged.Hash() != distinct.Hash(), "expected a forged separator byte not to collide with a real field boundary")
+	testutil.Assert(t, forged.StableHash() != distinct.StableHash(), "expected a forged separator byte not to collide with a real field boundary")
+	testutil.Assert(t, forged.Key() != distinct.Key(), "expected a forged separator byte not to collide with a real field boundary")
+
+	// A value containing the escape byte itself must likewise not forge a
+	// collision with a value that happens to already contain what looks
+	// like an escape sequence.
+	a := Labels{{"a", "b\xfeSc"}}
+	b := Labels{{"a", "b\xffc"}}
+	testutil.Assert(t, a.Hash() != b.Hash(), "expected a literal escape byte not to be confused with an escaped separator")
+}
+
+func TestLabels_Key(t *testing.T) {
+	a := Labels{{"aaa", "111"}, {"bbb", "222"}}
+	b := Labels{{"aaa", "111"}, {"bbb", "222"}}
+	testutil.Equals(t, a.Key(), b.Key())
+
+	// Two label sets that would only differ by where a value ends and the
+	// next name begins must not collapse onto the same key.
+	c := Labels{{"aaa", "111bbb"}, {"ccc", "222"}}
+	d := Labels{{"aaa", "111"}, {"bbbccc", "222"}}
+	testutil.Assert(t, c.Key() != d.Key(), "expected distinct label sets to produce distinct keys")
+
+	e := Labels{{"aaa", "111"}, {"bbb", "333"}}
+	testutil.Assert(t, a.Key() != e.Key(), "expected a different value to produce a different key")
+
+	m := map[string]int{}
+	m[a.Key()] = 1
+	m[e.Key()] = 2
+	testutil.Equals(t, 2, len(m))
+}
+
 func TestLabels_Get(t *testing.T) {
 	testutil.Equals(t, "", Labels{{"aaa", "111"}, {"bbb", "222"}}.Get("foo"))
 	testutil.Equals(t, "111", Labels{{"aaa", "111"}, {"bbb", "222"}}.Get("aaa"))
 }
 
 func TestLabels_Copy(t *testing.T) {
-	testutil.Equals(t, Labels{{"aaa", "111"}, {"bbb", "222"}}, Labels{{"aaa", "111"}, {"bbb", "222"}}.Copy())
+	orig := Labels{{"aaa", "111"}, {"bbb", "222"}}
+	testutil.Equals(t, Labels{{"aaa", "111"}, {"bbb", "222"}}, orig.Copy())
+
+	// The copy must not share a backing array with the original, so
+	// mutating one through its index does not affect the other.
+	cp := orig.Copy()
+	cp[0].Value = "changed"
+	testutil.Equals(t, "111", orig[0].Value)
 }
 
 func TestLabels_Map(t *testing.T) {
 	testutil.Equals(t, map[string]string{"aaa": "111", "bbb": "222"}, Labels{{"aaa", "111"}, {"bbb", "222"}}.Map())
 }
 
+func TestLabels_MapInto(t *testing.T) {
+	dst := map[string]string{"stale": "value"}
+	got := Labels{{"aaa", "111"}, {"bbb", "222"}}.MapInto(dst)
+	testutil.Equals(t, map[string]string{"aaa": "111", "bbb": "222"}, got)
+	_, ok := got["stale"]
+	testutil.Assert(t, !ok, "expected MapInto to clear stale entries from dst")
+
+	got = Labels{}.MapInto(got)
+	testutil.Equals(t, map[string]string{}, got)
+}
+
 func TestLabels_WithLabels(t *testing.T) {
 	testutil.Equals(t, Labels{{"aaa", "111"}, {"bbb", "222"}}, Labels{{"aaa", "111"}, {"bbb", "222"}, {"ccc", "333"}}.WithLabels("aaa", "bbb"))
 }
@@ -562,6 +947,114 @@ func TestLabels_WithoutLabels(t *testing.T) {
 	testutil.Equals(t, Labels{{"aaa", "111"}}, Labels{{"aaa", "111"}, {"bbb", "222"}, {MetricName, "333"}}.WithoutLabels("bbb"))
 }
 
+func TestLabels_WithPrefix(t *testing.T) {
+	ls := Labels{
+		{"__address__", "1.2.3.4:9100"},
+		{"__meta_kubernetes_namespace", "default"},
+		{"__meta_kubernetes_pod_name", "foo"},
+		{"instance", "1.2.3.4:9100"},
+		{"job", "node"},
+	}
+
+	testutil.Equals(t, Labels{
+		{"__meta_kubernetes_namespace", "default"},
+		{"__meta_kubernetes_pod_name", "foo"},
+	}, ls.WithPrefix("__meta_kubernetes_"))
+
+	testutil.Equals(t, Labels{}, ls.WithPrefix("__meta_consul_"))
+	testutil.Equals(t, Labels{}, Labels{}.WithPrefix("__meta_"))
+}
+
+func TestLabels_PrefixRange(t *testing.T) {
+	ls := Labels{
+		{"__address__", "1.2.3.4:9100"},
+		{"__meta_kubernetes_namespace", "default"},
+		{"__meta_kubernetes_pod_name", "foo"},
+		{"instance", "1.2.3.4:9100"},
+	}
+
+	start, end := ls.PrefixRange("__meta_kubernetes_")
+	testutil.Equals(t, 1, start)
+	testutil.Equals(t, 3, end)
+
+	start, end = ls.PrefixRange("does_not_exist")
+	testutil.Equals(t, start, end)
+}
+
+func TestEqualWithout(t *testing.T) {
+	cases := []struct {
+		a, b   Labels
+		names  []string
+		expect bool
+	}{
+		{
+			a:      Labels{{"__name__", "up"}, {"instance", "a"}, {"job", "p"}},
+			b:      Labels{{"__name__", "up"}, {"instance", "b"}, {"job", "p"}},
+			names:  []string{"instance"},
+			expect: true,
+		},
+		{
+			a:      Labels{{"__name__", "up"}, {"instance", "a"}, {"job", "p"}},
+			b:      Labels{{"__name__", "up"}, {"instance", "b"}, {"job", "q"}},
+			names:  []string{"instance"},
+			expect: false,
+		},
+		{
+			a:      Labels{{"__name__", "up"}, {"instance", "a"}},
+			b:      Labels{{"__name__", "up"}},
+			names:  []string{"instance"},
+			expect: true,
+		},
+		{
+			a:      Labels{{"__name__", "up"}, {"instance", "a"}},
+			b:      Labels{{"__name__", "up"}},
+			names:  nil,
+			expect: false,
+		},
+		{
+			a:      Labels{{"__name__", "up"}, {"instance", "a"}, {"replica", "1"}},
+			b:      Labels{{"__name__", "up"}, {"instance", "a"}, {"replica", "2"}},
+			names:  []string{"replica"},
+			expect: true,
+		},
+		{
+			a:      Labels{},
+			b:      Labels{},
+			names:  []string{"replica"},
+			expect: true,
+		},
+	}
+	for _, c := range cases {
+		testutil.Equals(t, c.expect, EqualWithout(c.a, c.b, c.names...))
+	}
+}
+
+func TestSlice_Dedupe(t *testing.T) {
+	slice := NewSlice([][]Label{
+		{{"a", "2"}},
+		{{"a", "1"}},
+		{{"a", "1"}},
+		{{"a", "3"}, {"b", "1"}},
+	})
+	deduped := slice.Dedupe()
+	testutil.Equals(t, Slice{
+		Labels{{"a", "1"}},
+		Labels{{"a", "2"}},
+		Labels{{"a", "3"}, {"b", "1"}},
+	}, deduped)
+}
+
+func TestNewSlice(t *testing.T) {
+	slice := NewSlice([][]Label{
+		{{"b", "2"}, {"a", "1"}},
+		{},
+	})
+	testutil.Equals(t, Slice{
+		Labels{{"a", "1"}, {"b", "2"}},
+		Labels{},
+	}, slice)
+}
+
 func TestBulider_NewBulider(t *testing.T) {
 	testutil.Equals(
 		t,
@@ -618,6 +1111,20 @@ func TestBuilder_Set(t *testing.T) {
 	)
 }
 
+func TestBuilder_SetIfAbsent(t *testing.T) {
+	b := NewBuilder(Labels{{"aaa", "111"}})
+	b.SetIfAbsent("aaa", "222")
+	b.SetIfAbsent("bbb", "222")
+	testutil.Equals(t, Labels{{"aaa", "111"}, {"bbb", "222"}}, b.Labels())
+}
+
+func TestMerge(t *testing.T) {
+	primary := Labels{{"aaa", "111"}, {"ccc", "333"}}
+	secondary := Labels{{"aaa", "999"}, {"bbb", "222"}}
+
+	testutil.Equals(t, Labels{{"aaa", "111"}, {"bbb", "222"}, {"ccc", "333"}}, Merge(primary, secondary))
+}
+
 func TestBuilder_Labels(t *testing.T) {
 	testutil.Equals(
 		t,
@@ -629,3 +1136,36 @@ func TestBuilder_Labels(t *testing.T) {
 		}).Labels(),
 	)
 }
+
+func TestBuilder_GetRange(t *testing.T) {
+	b := (&Builder{
+		base: Labels{{"aaa", "111"}, {"bbb", "222"}, {"ccc", "333"}},
+		del:  []string{"bbb"},
+		add:  []Label{{"ddd", "444"}},
+	})
+
+	testutil.Equals(t, "111", b.Get("aaa"))
+	testutil.Equals(t, "", b.Get("bbb"))
+	testutil.Equals(t, "444", b.Get("ddd"))
+	testutil.Equals(t, "", b.Get("eee"))
+
+	var got Labels
+	b.Range(func(l Label) { got = append(got, l) })
+	sort.Sort(got)
+	testutil.Equals(t, b.Labels(), got)
+}
+
+func TestNewBuilderFromPool(t *testing.T) {
+	base := Labels{{"aaa", "111"}}
+
+	b := NewBuilderFromPool(base)
+	b.Set("bbb", "222")
+	testutil.Equals(t, Labels{{"aaa", "111"}, {"bbb", "222"}}, b.Labels())
+	PutBuilder(b)
+
+	// A builder taken back out of the pool must not carry over state from
+	// its previous use once it's been Reset by NewBuilderFromPool.
+	b2 := NewBuilderFromPool(Labels{{"ccc", "333"}})
+	testutil.Equals(t, Labels{{"ccc", "333"}}, b2.Labels())
+	PutBuilder(b2)
+}
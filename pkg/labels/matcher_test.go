@@ -117,3 +117,24 @@ func TestInverse(t *testing.T) {
 		testutil.Equals(t, test.expected.Type, result.Type)
 	}
 }
+
+func TestSelector_Matches(t *testing.T) {
+	ls := Labels{{"__name__", "up"}, {"instance", "a"}, {"job", "node"}}
+
+	sel := Selector{
+		MustNewMatcher(MatchEqual, "__name__", "up"),
+		MustNewMatcher(MatchEqual, "job", "node"),
+	}
+	testutil.Assert(t, sel.Matches(ls), "expected selector to match")
+
+	sel = Selector{
+		MustNewMatcher(MatchEqual, "__name__", "up"),
+		MustNewMatcher(MatchEqual, "job", "other"),
+	}
+	testutil.Assert(t, !sel.Matches(ls), "expected selector not to match")
+
+	sel = Selector{
+		MustNewMatcher(MatchEqual, "missing", ""),
+	}
+	testutil.Assert(t, sel.Matches(ls), "expected an absent label to match against an empty value")
+}
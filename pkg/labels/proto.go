@@ -0,0 +1,54 @@
+// Copyright 2021 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labels
+
+import (
+	"sort"
+
+	"github.com/prometheus/prometheus/prompb"
+)
+
+// FromProtoLabels converts a []prompb.Label, as received over remote write,
+// into Labels without first building an intermediate []Label. Remote write
+// requires labels to already be sorted, so this only pays for a sort if it
+// finds the input isn't, rather than unconditionally re-sorting.
+func FromProtoLabels(labelPairs []prompb.Label) Labels {
+	result := make(Labels, 0, len(labelPairs))
+	sorted := true
+	for _, l := range labelPairs {
+		if n := len(result); n > 0 && l.Name < result[n-1].Name {
+			sorted = false
+		}
+		result = append(result, Label{Name: l.Name, Value: l.Value})
+	}
+	if !sorted {
+		sort.Sort(result)
+	}
+	return result
+}
+
+// AppendToProto appends ls to buf as prompb.Label pairs and returns the
+// result, reusing buf's backing array when it already has enough capacity
+// instead of allocating a new one, the same convention AppendNames and
+// AppendString use.
+func (ls Labels) AppendToProto(buf []prompb.Label) []prompb.Label {
+	result := buf[:0]
+	if cap(buf) < len(ls) {
+		result = make([]prompb.Label, 0, len(ls))
+	}
+	for _, l := range ls {
+		result = append(result, prompb.Label{Name: l.Name, Value: l.Value})
+	}
+	return result
+}
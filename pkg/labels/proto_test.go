@@ -0,0 +1,48 @@
+// Copyright 2021 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labels
+
+import (
+	"testing"
+
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/prometheus/prometheus/util/testutil"
+)
+
+func TestFromProtoLabels(t *testing.T) {
+	testutil.Equals(t, Labels{{"a", "1"}, {"b", "2"}}, FromProtoLabels([]prompb.Label{
+		{Name: "a", Value: "1"},
+		{Name: "b", Value: "2"},
+	}))
+
+	// Out-of-order input must still come back sorted.
+	testutil.Equals(t, Labels{{"a", "1"}, {"b", "2"}}, FromProtoLabels([]prompb.Label{
+		{Name: "b", Value: "2"},
+		{Name: "a", Value: "1"},
+	}))
+}
+
+func TestLabels_AppendToProto(t *testing.T) {
+	ls := Labels{{"a", "1"}, {"b", "2"}}
+	want := []prompb.Label{{Name: "a", Value: "1"}, {Name: "b", Value: "2"}}
+
+	testutil.Equals(t, want, ls.AppendToProto(nil))
+
+	// A buffer with enough capacity must be reused rather than replaced.
+	buf := make([]prompb.Label, 1, 2)
+	basePtr := &buf[0]
+	got := ls.AppendToProto(buf)
+	testutil.Equals(t, want, got)
+	testutil.Assert(t, &got[0] == basePtr, "AppendToProto should reuse buf's backing array when it has enough capacity")
+}
@@ -19,8 +19,23 @@ import (
 	"strings"
 )
 
+// FastRegexMatcher wraps a Prometheus label-matching regexp, which is always
+// fully anchored, and special-cases the common shapes -- an exact literal, an
+// alternation of literals, a literal prefix, or a literal suffix -- that can
+// be checked without running the compiled regexp at all.
 type FastRegexMatcher struct {
-	re     *regexp.Regexp
+	re *regexp.Regexp
+
+	// hasLiteral and literal hold an exact match, e.g. for the regex "foo".
+	hasLiteral bool
+	literal    string
+
+	// setMatches holds the alternatives of a regex that is just an
+	// alternation of literals, e.g. "foo|bar|baz".
+	setMatches map[string]struct{}
+
+	// prefix and suffix hold literal text that must appear at the start
+	// and/or end of a candidate string, checked before the regexp runs.
 	prefix string
 	suffix string
 }
@@ -41,13 +56,48 @@ func NewFastRegexMatcher(v string) (*FastRegexMatcher, error) {
 	}
 
 	if parsed.Op == syntax.OpConcat {
-		m.prefix, m.suffix = optimizeConcatRegex(parsed)
+		sub := stripAnchors(parsed.Sub)
+		switch len(sub) {
+		case 0:
+			m.hasLiteral = true
+		case 1:
+			m.trySingle(sub[0])
+		}
+		if !m.hasLiteral && m.setMatches == nil {
+			m.prefix, m.suffix = optimizeConcatRegex(parsed)
+		}
+	} else {
+		m.trySingle(parsed)
 	}
 
 	return m, nil
 }
 
+// trySingle sets m.literal or m.setMatches if r, taken on its own (i.e.
+// after any begin/end anchors have been peeled off), is an exact literal or
+// an alternation of literals.
+func (m *FastRegexMatcher) trySingle(r *syntax.Regexp) {
+	if lit, ok := asLiteral(r); ok {
+		m.hasLiteral = true
+		m.literal = lit
+		return
+	}
+	if alts, ok := asLiteralAlternatives(r); ok {
+		m.setMatches = make(map[string]struct{}, len(alts))
+		for _, alt := range alts {
+			m.setMatches[alt] = struct{}{}
+		}
+	}
+}
+
 func (m *FastRegexMatcher) MatchString(s string) bool {
+	if m.hasLiteral {
+		return s == m.literal
+	}
+	if m.setMatches != nil {
+		_, ok := m.setMatches[s]
+		return ok
+	}
 	if m.prefix != "" && !strings.HasPrefix(s, m.prefix) {
 		return false
 	}
@@ -61,20 +111,80 @@ func (m *FastRegexMatcher) GetRegexString() string {
 	return m.re.String()
 }
 
-// optimizeConcatRegex returns literal prefix/suffix text that can be safely
-// checked against the label value before running the regexp matcher.
-func optimizeConcatRegex(r *syntax.Regexp) (prefix, suffix string) {
-	sub := r.Sub
-
-	// We can safely remove begin and end text matchers respectively
-	// at the beginning and end of the regexp.
+// stripAnchors removes the begin/end text matchers a Prometheus regex is
+// always wrapped in, since they carry no information once we know sub came
+// from such a wrapped expression.
+func stripAnchors(sub []*syntax.Regexp) []*syntax.Regexp {
 	if len(sub) > 0 && sub[0].Op == syntax.OpBeginText {
 		sub = sub[1:]
 	}
 	if len(sub) > 0 && sub[len(sub)-1].Op == syntax.OpEndText {
 		sub = sub[:len(sub)-1]
 	}
+	return sub
+}
+
+// asLiteral returns the exact string r matches, if r is made up entirely of
+// literal text.
+func asLiteral(r *syntax.Regexp) (string, bool) {
+	switch r.Op {
+	case syntax.OpEmptyMatch:
+		return "", true
+	case syntax.OpLiteral:
+		return string(r.Rune), true
+	case syntax.OpCapture:
+		return asLiteral(r.Sub[0])
+	case syntax.OpConcat:
+		var sb strings.Builder
+		for _, s := range r.Sub {
+			lit, ok := asLiteral(s)
+			if !ok {
+				return "", false
+			}
+			sb.WriteString(lit)
+		}
+		return sb.String(), true
+	}
+	return "", false
+}
+
+// asLiteralAlternatives returns the set of strings r can match, if r is an
+// alternation of literals (e.g. "foo|bar") or a character class made up
+// entirely of single-rune alternatives (e.g. "[abc]").
+func asLiteralAlternatives(r *syntax.Regexp) ([]string, bool) {
+	switch r.Op {
+	case syntax.OpCapture:
+		return asLiteralAlternatives(r.Sub[0])
+	case syntax.OpAlternate:
+		alts := make([]string, 0, len(r.Sub))
+		for _, s := range r.Sub {
+			lit, ok := asLiteral(s)
+			if !ok {
+				return nil, false
+			}
+			alts = append(alts, lit)
+		}
+		return alts, true
+	case syntax.OpCharClass:
+		if len(r.Rune)%2 != 0 {
+			return nil, false
+		}
+		alts := make([]string, 0, len(r.Rune)/2)
+		for i := 0; i < len(r.Rune); i += 2 {
+			if r.Rune[i] != r.Rune[i+1] {
+				return nil, false
+			}
+			alts = append(alts, string(r.Rune[i]))
+		}
+		return alts, true
+	}
+	return nil, false
+}
 
+// optimizeConcatRegex returns literal prefix/suffix text that can be safely
+// checked against the label value before running the regexp matcher.
+func optimizeConcatRegex(r *syntax.Regexp) (prefix, suffix string) {
+	sub := stripAnchors(r.Sub)
 	if len(sub) == 0 {
 		return
 	}
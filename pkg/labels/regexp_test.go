@@ -45,6 +45,12 @@ func TestNewFastRegexMatcher(t *testing.T) {
 		{regex: ".*", value: "foo", expected: true},
 		{regex: "", value: "foo", expected: false},
 		{regex: "", value: "", expected: true},
+		{regex: "foo", value: "foo", expected: true},
+		{regex: "foo", value: "foobar", expected: false},
+		{regex: "foo|bar|baz", value: "bar", expected: true},
+		{regex: "foo|bar|baz", value: "bart", expected: false},
+		{regex: "[abc]", value: "b", expected: true},
+		{regex: "[abc]", value: "d", expected: false},
 	}
 
 	for _, c := range cases {
@@ -54,6 +60,20 @@ func TestNewFastRegexMatcher(t *testing.T) {
 	}
 }
 
+func TestFastRegexMatcher_Optimizations(t *testing.T) {
+	m, err := NewFastRegexMatcher("foo")
+	testutil.Ok(t, err)
+	testutil.Assert(t, m.hasLiteral, "expected an exact literal regex to be recognized")
+
+	m, err = NewFastRegexMatcher("foo|bar")
+	testutil.Ok(t, err)
+	testutil.Equals(t, map[string]struct{}{"foo": {}, "bar": {}}, m.setMatches)
+
+	m, err = NewFastRegexMatcher("foo.*")
+	testutil.Ok(t, err)
+	testutil.Assert(t, !m.hasLiteral && m.setMatches == nil, "expected a non-literal regex to fall back to prefix/suffix matching")
+}
+
 func TestOptimizeConcatRegex(t *testing.T) {
 	cases := []struct {
 		regex  string
@@ -22,7 +22,8 @@ import (
 	"github.com/pkg/errors"
 )
 
-// Slice is a sortable slice of label sets.
+// Slice is a sortable slice of label sets. See Dedupe and NewSlice in
+// labels.go for the batch sort/de-duplicate helpers built on top of it.
 type Slice []Labels
 
 func (s Slice) Len() int           { return len(s) }
@@ -32,10 +33,17 @@ func (s Slice) Less(i, j int) bool { return Compare(s[i], s[j]) < 0 }
 // Selector holds constraints for matching against a label set.
 type Selector []*Matcher
 
-// Matches returns whether the labels satisfy all matchers.
-func (s Selector) Matches(labels Labels) bool {
+// Matches returns whether the labels satisfy all matchers. It relies on ls
+// being sorted by name, as Labels always is, to look up each matcher's label
+// by binary search rather than scanning the whole set.
+func (s Selector) Matches(ls Labels) bool {
 	for _, m := range s {
-		if v := labels.Get(m.Name); !m.Matches(v) {
+		i := sort.Search(len(ls), func(i int) bool { return ls[i].Name >= m.Name })
+		v := ""
+		if i < len(ls) && ls[i].Name == m.Name {
+			v = ls[i].Value
+		}
+		if !m.Matches(v) {
 			return false
 		}
 	}
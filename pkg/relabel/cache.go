@@ -0,0 +1,124 @@
+// Copyright 2021 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relabel
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+// DefaultCacheMaxSize is used by NewCache when given a maxSize of 0.
+const DefaultCacheMaxSize = 10000
+
+// cacheEntry holds the outcome of relabeling a single input label set: the
+// resulting labels if it was kept, or nothing if it was dropped.
+type cacheEntry struct {
+	key  uint64
+	lbls labels.Labels
+	keep bool
+}
+
+// Cache memoizes the result of running a fixed sequence of Configs against a
+// label set, keyed by the hash of the input labels. A single Cache is meant
+// to be created once per relabeling pipeline (e.g. per scrape config) and
+// reused across discovery syncs: on every sync interval, most targets
+// reappear with identical labels, and re-running every Config against them
+// again is wasted work. It is bounded to avoid growing without limit as
+// targets churn, and is safe for concurrent use.
+type Cache struct {
+	maxSize int
+
+	mtx   sync.Mutex
+	ll    *list.List
+	items map[uint64]*list.Element
+}
+
+// NewCache returns a Cache that memoizes the result of at most maxSize
+// distinct input label sets, evicting the least recently used entry once
+// full. A maxSize of 0 uses DefaultCacheMaxSize.
+func NewCache(maxSize int) *Cache {
+	if maxSize <= 0 {
+		maxSize = DefaultCacheMaxSize
+	}
+	return &Cache{
+		maxSize: maxSize,
+		ll:      list.New(),
+		items:   make(map[uint64]*list.Element),
+	}
+}
+
+// ProcessBuilder behaves exactly like the package-level ProcessBuilder for
+// the same lb and cfgs, except that the result is memoized by the hash of
+// lb's labels prior to relabeling. Repeated calls with an unchanged input
+// label set skip re-evaluating cfgs entirely.
+//
+// cfgs is assumed to be the same sequence of Configs on every call; Cache
+// does not detect a change of cfgs on its own and must be discarded (e.g. on
+// config reload) whenever cfgs changes.
+func (c *Cache) ProcessBuilder(lb *labels.Builder, cfgs ...*Config) bool {
+	key := lb.Labels().Hash()
+
+	if entry, ok := c.get(key); ok {
+		if !entry.keep {
+			return false
+		}
+		lb.Reset(entry.lbls)
+		return true
+	}
+
+	keep := ProcessBuilder(lb, cfgs...)
+
+	entry := &cacheEntry{key: key, keep: keep}
+	if keep {
+		entry.lbls = lb.Labels()
+	}
+	c.add(entry)
+
+	return keep
+}
+
+func (c *Cache) get(key uint64) (*cacheEntry, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(e)
+	return e.Value.(*cacheEntry), true
+}
+
+func (c *Cache) add(entry *cacheEntry) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if e, ok := c.items[entry.key]; ok {
+		c.ll.MoveToFront(e)
+		e.Value = entry
+		return
+	}
+	c.items[entry.key] = c.ll.PushFront(entry)
+
+	for c.ll.Len() > c.maxSize {
+		oldest := c.ll.Back()
+		if oldest == nil {
+			break
+		}
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).key)
+	}
+}
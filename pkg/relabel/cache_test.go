@@ -0,0 +1,64 @@
+// Copyright 2021 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relabel
+
+import (
+	"testing"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/util/testutil"
+)
+
+func TestCacheProcessBuilder(t *testing.T) {
+	cfgs := []*Config{
+		{
+			SourceLabels: model.LabelNames{"a"},
+			Regex:        MustNewRegexp("y"),
+			Action:       Drop,
+		},
+	}
+
+	c := NewCache(0)
+
+	kept := labels.FromStrings("a", "x", "b", "c")
+	lb := labels.NewBuilder(kept)
+	testutil.Assert(t, c.ProcessBuilder(lb, cfgs...), "expected target to be kept")
+	testutil.Equals(t, kept, lb.Labels())
+
+	// A second call with the same input labels should hit the cache and
+	// produce the same result without re-running cfgs.
+	lb.Reset(kept)
+	testutil.Assert(t, c.ProcessBuilder(lb, cfgs...), "expected cached target to be kept")
+	testutil.Equals(t, kept, lb.Labels())
+
+	dropped := labels.FromStrings("a", "y")
+	lb.Reset(dropped)
+	testutil.Assert(t, !c.ProcessBuilder(lb, cfgs...), "expected target to be dropped")
+
+	lb.Reset(dropped)
+	testutil.Assert(t, !c.ProcessBuilder(lb, cfgs...), "expected cached target to be dropped")
+}
+
+func TestCacheEviction(t *testing.T) {
+	c := NewCache(1)
+
+	lb := labels.NewBuilder(labels.FromStrings("a", "1"))
+	c.ProcessBuilder(lb)
+	lb.Reset(labels.FromStrings("a", "2"))
+	c.ProcessBuilder(lb)
+
+	testutil.Equals(t, 1, len(c.items))
+}
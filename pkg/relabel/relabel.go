@@ -19,6 +19,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/cespare/xxhash"
 	"github.com/pkg/errors"
 	"github.com/prometheus/common/model"
 
@@ -29,10 +30,11 @@ var (
 	relabelTarget = regexp.MustCompile(`^(?:(?:[a-zA-Z_]|\$(?:\{\w+\}|\w+))+\w*)+$`)
 
 	DefaultRelabelConfig = Config{
-		Action:      Replace,
-		Separator:   ";",
-		Regex:       MustNewRegexp("(.*)"),
-		Replacement: "$1",
+		Action:       Replace,
+		Separator:    ";",
+		Regex:        MustNewRegexp("(.*)"),
+		Replacement:  "$1",
+		HashFunction: MD5HashFunction,
 	}
 )
 
@@ -54,8 +56,49 @@ const (
 	LabelDrop Action = "labeldrop"
 	// LabelKeep drops any label not matching the regex.
 	LabelKeep Action = "labelkeep"
+	// Lowercase maps concatenated source label values to their lower case.
+	Lowercase Action = "lowercase"
+	// Uppercase maps concatenated source label values to their upper case.
+	Uppercase Action = "uppercase"
+	// KeepEqual drops targets for which the concatenated source labels do
+	// not match the value of the target label.
+	KeepEqual Action = "keepequal"
+	// DropEqual drops targets for which the concatenated source labels
+	// match the value of the target label.
+	DropEqual Action = "dropequal"
+	// Split matches the concatenated source labels against regex and
+	// writes each named capture group in regex to the label of the same
+	// name, splitting a single value into several labels in one rule.
+	Split Action = "split"
+)
+
+// HashFunction is the hash function used by the hashmod action to turn the
+// concatenated source label values into a number to take the modulus of.
+type HashFunction string
+
+const (
+	// MD5HashFunction hashes with md5 and is the default, kept for backwards
+	// compatibility with existing configurations.
+	MD5HashFunction HashFunction = "md5"
+	// XXHashFunction hashes with the same 64-bit xxhash algorithm used by
+	// labels.Labels.Hash, which is faster and better distributed than md5.
+	XXHashFunction HashFunction = "xxhash"
 )
 
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (h *HashFunction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	switch fn := HashFunction(strings.ToLower(s)); fn {
+	case MD5HashFunction, XXHashFunction:
+		*h = fn
+		return nil
+	}
+	return errors.Errorf("unknown hash function %q", s)
+}
+
 // UnmarshalYAML implements the yaml.Unmarshaler interface.
 func (a *Action) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var s string
@@ -63,7 +106,7 @@ func (a *Action) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		return err
 	}
 	switch act := Action(strings.ToLower(s)); act {
-	case Replace, Keep, Drop, HashMod, LabelMap, LabelDrop, LabelKeep:
+	case Replace, Keep, Drop, HashMod, LabelMap, LabelDrop, LabelKeep, Lowercase, Uppercase, KeepEqual, DropEqual, Split:
 		*a = act
 		return nil
 	}
@@ -80,7 +123,11 @@ type Config struct {
 	// Regex against which the concatenation is matched.
 	Regex Regexp `yaml:"regex,omitempty"`
 	// Modulus to take of the hash of concatenated values from the source labels.
+	// May be larger than 1<<32; the hash is always taken as a uint64.
 	Modulus uint64 `yaml:"modulus,omitempty"`
+	// HashFunction is the hash function used for the hashmod action. Defaults
+	// to MD5HashFunction for backwards compatibility.
+	HashFunction HashFunction `yaml:"hash_function,omitempty"`
 	// TargetLabel is the label to which the resulting string is written in a replacement.
 	// Regexp interpolation is allowed for the replace action.
 	TargetLabel string `yaml:"target_label,omitempty"`
@@ -103,10 +150,10 @@ func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	if c.Modulus == 0 && c.Action == HashMod {
 		return errors.Errorf("relabel configuration for hashmod requires non-zero modulus")
 	}
-	if (c.Action == Replace || c.Action == HashMod) && c.TargetLabel == "" {
+	if (c.Action == Replace || c.Action == HashMod || c.Action == Lowercase || c.Action == Uppercase || c.Action == KeepEqual || c.Action == DropEqual) && c.TargetLabel == "" {
 		return errors.Errorf("relabel configuration for %s action requires 'target_label' value", c.Action)
 	}
-	if c.Action == Replace && !relabelTarget.MatchString(c.TargetLabel) {
+	if (c.Action == Replace || c.Action == Lowercase || c.Action == Uppercase || c.Action == KeepEqual || c.Action == DropEqual) && !relabelTarget.MatchString(c.TargetLabel) {
 		return errors.Errorf("%q is invalid 'target_label' for %s action", c.TargetLabel, c.Action)
 	}
 	if c.Action == LabelMap && !relabelTarget.MatchString(c.Replacement) {
@@ -115,6 +162,9 @@ func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	if c.Action == HashMod && !model.LabelName(c.TargetLabel).IsValid() {
 		return errors.Errorf("%q is invalid 'target_label' for %s action", c.TargetLabel, c.Action)
 	}
+	if c.Action == Split && !hasNamedCaptureGroup(c.Regex) {
+		return errors.Errorf("relabel configuration for split action requires at least one named capture group in 'regex'")
+	}
 
 	if c.Action == LabelDrop || c.Action == LabelKeep {
 		if c.SourceLabels != nil ||
@@ -154,6 +204,17 @@ func MustNewRegexp(s string) Regexp {
 	return re
 }
 
+// hasNamedCaptureGroup reports whether re has at least one named capture
+// group, as required by the split action to know which labels to write.
+func hasNamedCaptureGroup(re Regexp) bool {
+	for _, name := range re.SubexpNames() {
+		if name != "" {
+			return true
+		}
+	}
+	return false
+}
+
 // UnmarshalYAML implements the yaml.Unmarshaler interface.
 func (re *Regexp) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var s string
@@ -180,33 +241,46 @@ func (re Regexp) MarshalYAML() (interface{}, error) {
 // are applied in order of input.
 // If a label set is dropped, nil is returned.
 // May return the input labelSet modified.
-func Process(labels labels.Labels, cfgs ...*Config) labels.Labels {
+func Process(lbls labels.Labels, cfgs ...*Config) labels.Labels {
+	lb := labels.NewBuilder(lbls)
+	if !ProcessBuilder(lb, cfgs...) {
+		return nil
+	}
+	return lb.Labels()
+}
+
+// ProcessBuilder is like Process, but mutates lb in place and reports
+// whether the target should be kept, instead of materializing a
+// labels.Labels between every rule. Process pays that conversion cost once
+// per rule; on a large Kubernetes cluster with many relabel rules applied to
+// every discovered target, that's the cost that dominates target-sync CPU.
+// Callers that already hold a Builder, such as a scrape target assembling
+// its final label set, should use this instead of Process to apply all of
+// cfgs against it directly.
+func ProcessBuilder(lb *labels.Builder, cfgs ...*Config) bool {
 	for _, cfg := range cfgs {
-		labels = relabel(labels, cfg)
-		if labels == nil {
-			return nil
+		if keep := relabel(lb, cfg); !keep {
+			return false
 		}
 	}
-	return labels
+	return true
 }
 
-func relabel(lset labels.Labels, cfg *Config) labels.Labels {
+func relabel(lb *labels.Builder, cfg *Config) bool {
 	values := make([]string, 0, len(cfg.SourceLabels))
 	for _, ln := range cfg.SourceLabels {
-		values = append(values, lset.Get(string(ln)))
+		values = append(values, lb.Get(string(ln)))
 	}
 	val := strings.Join(values, cfg.Separator)
 
-	lb := labels.NewBuilder(lset)
-
 	switch cfg.Action {
 	case Drop:
 		if cfg.Regex.MatchString(val) {
-			return nil
+			return false
 		}
 	case Keep:
 		if !cfg.Regex.MatchString(val) {
-			return nil
+			return false
 		}
 	case Replace:
 		indexes := cfg.Regex.FindStringSubmatchIndex(val)
@@ -226,32 +300,68 @@ func relabel(lset labels.Labels, cfg *Config) labels.Labels {
 		}
 		lb.Set(string(target), string(res))
 	case HashMod:
-		mod := sum64(md5.Sum([]byte(val))) % cfg.Modulus
-		lb.Set(cfg.TargetLabel, fmt.Sprintf("%d", mod))
+		var sum uint64
+		switch cfg.HashFunction {
+		case XXHashFunction:
+			sum = xxhash.Sum64([]byte(val))
+		default:
+			sum = sum64(md5.Sum([]byte(val)))
+		}
+		lb.Set(cfg.TargetLabel, fmt.Sprintf("%d", sum%cfg.Modulus))
+	case Lowercase:
+		lb.Set(cfg.TargetLabel, strings.ToLower(val))
+	case Uppercase:
+		lb.Set(cfg.TargetLabel, strings.ToUpper(val))
+	case KeepEqual:
+		if val != lb.Get(cfg.TargetLabel) {
+			return false
+		}
+	case DropEqual:
+		if val == lb.Get(cfg.TargetLabel) {
+			return false
+		}
+	case Split:
+		match := cfg.Regex.FindStringSubmatch(val)
+		if match == nil {
+			break
+		}
+		for i, name := range cfg.Regex.SubexpNames() {
+			if i == 0 || name == "" {
+				continue
+			}
+			lb.Set(name, match[i])
+		}
 	case LabelMap:
-		for _, l := range lset {
+		var renames [][2]string
+		lb.Range(func(l labels.Label) {
 			if cfg.Regex.MatchString(l.Name) {
-				res := cfg.Regex.ReplaceAllString(l.Name, cfg.Replacement)
-				lb.Set(res, l.Value)
+				renames = append(renames, [2]string{l.Name, l.Value})
 			}
+		})
+		for _, r := range renames {
+			lb.Set(cfg.Regex.ReplaceAllString(r[0], cfg.Replacement), r[1])
 		}
 	case LabelDrop:
-		for _, l := range lset {
+		var names []string
+		lb.Range(func(l labels.Label) {
 			if cfg.Regex.MatchString(l.Name) {
-				lb.Del(l.Name)
+				names = append(names, l.Name)
 			}
-		}
+		})
+		lb.Del(names...)
 	case LabelKeep:
-		for _, l := range lset {
+		var names []string
+		lb.Range(func(l labels.Label) {
 			if !cfg.Regex.MatchString(l.Name) {
-				lb.Del(l.Name)
+				names = append(names, l.Name)
 			}
-		}
+		})
+		lb.Del(names...)
 	default:
 		panic(errors.Errorf("relabel: unknown relabel action type %q", cfg.Action))
 	}
 
-	return lb.Labels()
+	return true
 }
 
 // sum64 sums the md5 hash to an uint64.
@@ -233,6 +233,155 @@ func TestRelabel(t *testing.T) {
 				"d": "976",
 			}),
 		},
+		{
+			input: labels.FromMap(map[string]string{
+				"a": "foo",
+				"b": "bar",
+				"c": "baz",
+			}),
+			relabel: []*Config{
+				{
+					SourceLabels: model.LabelNames{"c"},
+					TargetLabel:  "d",
+					Separator:    ";",
+					Action:       HashMod,
+					Modulus:      1000,
+					HashFunction: XXHashFunction,
+				},
+			},
+			output: labels.FromMap(map[string]string{
+				"a": "foo",
+				"b": "bar",
+				"c": "baz",
+				"d": "324",
+			}),
+		},
+		{
+			input: labels.FromMap(map[string]string{
+				"a": "fOo",
+				"b": "bAr",
+				"c": "baz",
+			}),
+			relabel: []*Config{
+				{
+					SourceLabels: model.LabelNames{"a"},
+					TargetLabel:  "d",
+					Action:       Uppercase,
+				},
+				{
+					SourceLabels: model.LabelNames{"b"},
+					TargetLabel:  "e",
+					Action:       Lowercase,
+				},
+			},
+			output: labels.FromMap(map[string]string{
+				"a": "fOo",
+				"b": "bAr",
+				"c": "baz",
+				"d": "FOO",
+				"e": "bar",
+			}),
+		},
+		{
+			input: labels.FromMap(map[string]string{
+				"a": "foo",
+				"b": "foo",
+				"c": "bar",
+			}),
+			relabel: []*Config{
+				{
+					SourceLabels: model.LabelNames{"a"},
+					TargetLabel:  "b",
+					Action:       KeepEqual,
+				},
+			},
+			output: labels.FromMap(map[string]string{
+				"a": "foo",
+				"b": "foo",
+				"c": "bar",
+			}),
+		},
+		{
+			input: labels.FromMap(map[string]string{
+				"a": "foo",
+				"b": "bar",
+				"c": "bar",
+			}),
+			relabel: []*Config{
+				{
+					SourceLabels: model.LabelNames{"a"},
+					TargetLabel:  "b",
+					Action:       KeepEqual,
+				},
+			},
+			output: nil,
+		},
+		{
+			input: labels.FromMap(map[string]string{
+				"a": "foo",
+				"b": "foo",
+				"c": "bar",
+			}),
+			relabel: []*Config{
+				{
+					SourceLabels: model.LabelNames{"a"},
+					TargetLabel:  "b",
+					Action:       DropEqual,
+				},
+			},
+			output: nil,
+		},
+		{
+			input: labels.FromMap(map[string]string{
+				"a": "foo",
+				"b": "bar",
+				"c": "bar",
+			}),
+			relabel: []*Config{
+				{
+					SourceLabels: model.LabelNames{"a"},
+					TargetLabel:  "b",
+					Action:       DropEqual,
+				},
+			},
+			output: labels.FromMap(map[string]string{
+				"a": "foo",
+				"b": "bar",
+				"c": "bar",
+			}),
+		},
+		{
+			input: labels.FromMap(map[string]string{
+				"tags": "env:prod,team:db",
+			}),
+			relabel: []*Config{
+				{
+					SourceLabels: model.LabelNames{"tags"},
+					Regex:        MustNewRegexp(`env:(?P<env>[^,]+),team:(?P<team>[^,]+)`),
+					Action:       Split,
+				},
+			},
+			output: labels.FromMap(map[string]string{
+				"tags": "env:prod,team:db",
+				"env":  "prod",
+				"team": "db",
+			}),
+		},
+		{
+			input: labels.FromMap(map[string]string{
+				"tags": "nope",
+			}),
+			relabel: []*Config{
+				{
+					SourceLabels: model.LabelNames{"tags"},
+					Regex:        MustNewRegexp(`env:(?P<env>[^,]+),team:(?P<team>[^,]+)`),
+					Action:       Split,
+				},
+			},
+			output: labels.FromMap(map[string]string{
+				"tags": "nope",
+			}),
+		},
 		{
 			input: labels.FromMap(map[string]string{
 				"a":  "foo",
@@ -415,6 +564,15 @@ func TestRelabel(t *testing.T) {
 	for _, test := range tests {
 		res := Process(test.input, test.relabel...)
 		testutil.Equals(t, test.output, res)
+
+		lb := labels.NewBuilder(test.input)
+		keep := ProcessBuilder(lb, test.relabel...)
+		if test.output == nil {
+			testutil.Assert(t, !keep, "expected ProcessBuilder to drop the target")
+		} else {
+			testutil.Assert(t, keep, "expected ProcessBuilder to keep the target")
+			testutil.Equals(t, test.output, lb.Labels())
+		}
 	}
 }
 
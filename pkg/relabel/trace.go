@@ -0,0 +1,51 @@
+// Copyright 2021 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relabel
+
+import "github.com/prometheus/prometheus/pkg/labels"
+
+// RuleTrace records the effect of applying a single Config during a traced
+// relabeling pass run by ProcessWithTrace: the index of the rule within the
+// cfgs sequence passed to ProcessWithTrace, the rule itself, the label set
+// that resulted from applying it, and whether the target was still kept
+// afterwards. Once a rule drops the target, Labels is nil and no further
+// rules are evaluated.
+type RuleTrace struct {
+	RuleIndex int
+	Rule      *Config
+	Labels    labels.Labels
+	Kept      bool
+}
+
+// ProcessWithTrace behaves like Process, but additionally returns one
+// RuleTrace per Config in cfgs that was evaluated, in the order they ran.
+// It lets a caller such as a relabel debugger show exactly which rule
+// changed or dropped a target's labels, instead of only the final outcome.
+func ProcessWithTrace(lbls labels.Labels, cfgs ...*Config) (labels.Labels, []RuleTrace) {
+	lb := labels.NewBuilder(lbls)
+	traces := make([]RuleTrace, 0, len(cfgs))
+
+	for i, cfg := range cfgs {
+		kept := relabel(lb, cfg)
+		trace := RuleTrace{RuleIndex: i, Rule: cfg, Kept: kept}
+		if kept {
+			trace.Labels = lb.Labels()
+		}
+		traces = append(traces, trace)
+		if !kept {
+			return nil, traces
+		}
+	}
+	return lb.Labels(), traces
+}
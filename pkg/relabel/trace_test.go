@@ -0,0 +1,76 @@
+// Copyright 2021 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relabel
+
+import (
+	"testing"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/util/testutil"
+)
+
+func TestProcessWithTraceKept(t *testing.T) {
+	cfgs := []*Config{
+		{
+			SourceLabels: model.LabelNames{"a"},
+			Regex:        MustNewRegexp("(.*)"),
+			TargetLabel:  "b",
+			Replacement:  "$1-suffix",
+			Action:       Replace,
+		},
+		{
+			SourceLabels: model.LabelNames{"b"},
+			Regex:        MustNewRegexp("x-suffix"),
+			Action:       Keep,
+		},
+	}
+
+	out, traces := ProcessWithTrace(labels.FromStrings("a", "x"), cfgs...)
+	testutil.Equals(t, labels.FromStrings("a", "x", "b", "x-suffix"), out)
+
+	testutil.Equals(t, 2, len(traces))
+	testutil.Equals(t, 0, traces[0].RuleIndex)
+	testutil.Assert(t, traces[0].Kept, "first rule should keep the target")
+	testutil.Equals(t, labels.FromStrings("a", "x", "b", "x-suffix"), traces[0].Labels)
+	testutil.Equals(t, 1, traces[1].RuleIndex)
+	testutil.Assert(t, traces[1].Kept, "second rule should keep the target")
+}
+
+func TestProcessWithTraceDropped(t *testing.T) {
+	cfgs := []*Config{
+		{
+			SourceLabels: model.LabelNames{"a"},
+			Regex:        MustNewRegexp("drop-me"),
+			Action:       Drop,
+		},
+		{
+			SourceLabels: model.LabelNames{"a"},
+			Regex:        MustNewRegexp("(.*)"),
+			TargetLabel:  "never_set",
+			Replacement:  "$1",
+			Action:       Replace,
+		},
+	}
+
+	out, traces := ProcessWithTrace(labels.FromStrings("a", "drop-me"), cfgs...)
+	testutil.Assert(t, out == nil, "expected target to be dropped")
+
+	// The dropping rule is traced, but the rule after it never runs.
+	testutil.Equals(t, 1, len(traces))
+	testutil.Equals(t, 0, traces[0].RuleIndex)
+	testutil.Assert(t, !traces[0].Kept, "expected first rule to drop the target")
+	testutil.Assert(t, traces[0].Labels == nil, "dropped trace entry should carry no labels")
+}
@@ -106,6 +106,12 @@ type RuleGroup struct {
 	Name     string         `yaml:"name"`
 	Interval model.Duration `yaml:"interval,omitempty"`
 	Rules    []RuleNode     `yaml:"rules"`
+	// DryRun evaluates the group's rules every interval, but does not write
+	// their results and does not send any alert notifications. It allows
+	// rolling out new or changed rules and observing their behavior (e.g. via
+	// the /api/v1/rules endpoint) before they can affect stored series or
+	// fire alerts.
+	DryRun bool `yaml:"dry_run,omitempty"`
 }
 
 // Rule describes an alerting or recording rule.
@@ -114,6 +120,7 @@ type Rule struct {
 	Alert       string            `yaml:"alert,omitempty"`
 	Expr        string            `yaml:"expr"`
 	For         model.Duration    `yaml:"for,omitempty"`
+	ForTemplate string            `yaml:"for_template,omitempty"`
 	Labels      map[string]string `yaml:"labels,omitempty"`
 	Annotations map[string]string `yaml:"annotations,omitempty"`
 }
@@ -124,6 +131,7 @@ type RuleNode struct {
 	Alert       yaml.Node         `yaml:"alert,omitempty"`
 	Expr        yaml.Node         `yaml:"expr"`
 	For         model.Duration    `yaml:"for,omitempty"`
+	ForTemplate string            `yaml:"for_template,omitempty"`
 	Labels      map[string]string `yaml:"labels,omitempty"`
 	Annotations map[string]string `yaml:"annotations,omitempty"`
 }
@@ -175,6 +183,12 @@ func (r *RuleNode) Validate() (nodes []WrappedError) {
 				node: &r.Record,
 			})
 		}
+		if r.ForTemplate != "" {
+			nodes = append(nodes, WrappedError{
+				err:  errors.Errorf("invalid field 'for_template' in recording rule"),
+				node: &r.Record,
+			})
+		}
 		if !model.IsValidMetricName(model.LabelValue(r.Record.Value)) {
 			nodes = append(nodes, WrappedError{
 				err:  errors.Errorf("invalid recording rule name: %s", r.Record.Value),
@@ -256,6 +270,13 @@ func testTemplateParsing(rl *RuleNode) (errs []error) {
 		}
 	}
 
+	// Parsing ForTemplate.
+	if rl.ForTemplate != "" {
+		if err := parseTest(rl.ForTemplate); err != nil {
+			errs = append(errs, errors.Wrap(err, "for_template"))
+		}
+	}
+
 	return errs
 }
 
@@ -148,6 +148,40 @@ groups:
     annotations:
       summary: "Instance {{ $labels.instance }} down"
       description: "{{$labels.quantile * 100}}"
+`,
+			shouldPass: false,
+		},
+		{
+			// for_template using $labels, per-tier hold duration.
+			ruleString: `
+groups:
+- name: example
+  rules:
+  - alert: InstanceDown
+    expr: up == 0
+    for: 5m
+    for_template: "{{ $labels.slo_for }}"
+    labels:
+      severity: "page"
+    annotations:
+      summary: "Instance {{ $labels.instance }} down"
+`,
+			shouldPass: true,
+		},
+		{
+			// for_template with `$label` instead of `$labels`.
+			ruleString: `
+groups:
+- name: example
+  rules:
+  - alert: InstanceDown
+    expr: up == 0
+    for: 5m
+    for_template: "{{ $label.slo_for }}"
+    labels:
+      severity: "page"
+    annotations:
+      summary: "Instance {{ $labels.instance }} down"
 `,
 			shouldPass: false,
 		},
@@ -0,0 +1,156 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package scraperules implements scrape-time recording: aggregating
+// high-cardinality series scraped off a single target into a lower
+// cardinality sum before they reach the rest of the pipeline, the same way
+// a recording rule would with "sum without(...)", but evaluated directly on
+// the samples of one scrape instead of read back out of storage.
+//
+// This package only computes the aggregation; wiring it into the scrape
+// loop's sample-by-sample ingestion path is left to the caller.
+package scraperules
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+// Config is the configuration for one scrape rule.
+type Config struct {
+	// MetricName selects the raw series, by __name__, that this rule
+	// aggregates.
+	MetricName string `yaml:"metric_name"`
+	// By, if non-empty, lists the label names to group by; all others are
+	// dropped before summing. Mutually exclusive with Without.
+	By []string `yaml:"by,omitempty"`
+	// Without lists label names to drop before grouping; samples are
+	// summed across whatever remains. Mutually exclusive with By.
+	Without []string `yaml:"without,omitempty"`
+	// TargetMetricName is the __name__ the aggregated series is recorded
+	// under. Defaults to MetricName if empty.
+	TargetMetricName string `yaml:"target_metric_name,omitempty"`
+	// DropSource removes the raw, per-series samples matching MetricName
+	// once they have been aggregated, so only the aggregate is ingested.
+	DropSource bool `yaml:"drop_source,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain Config
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.MetricName == "" {
+		return errors.New("scrape rule requires a metric_name")
+	}
+	if len(c.By) > 0 && len(c.Without) > 0 {
+		return errors.New("scrape rule must not specify both by and without")
+	}
+	return nil
+}
+
+// targetName returns the __name__ the aggregated series should carry.
+func (c *Config) targetName() string {
+	if c.TargetMetricName != "" {
+		return c.TargetMetricName
+	}
+	return c.MetricName
+}
+
+// groupingLabels returns the labels of the aggregate that ls contributes to.
+func (c *Config) groupingLabels(ls labels.Labels) labels.Labels {
+	b := labels.NewBuilder(ls)
+	if len(c.By) > 0 {
+		keep := make(map[string]struct{}, len(c.By))
+		for _, n := range c.By {
+			keep[n] = struct{}{}
+		}
+		for _, l := range ls {
+			if l.Name == labels.MetricName {
+				continue
+			}
+			if _, ok := keep[l.Name]; !ok {
+				b.Del(l.Name)
+			}
+		}
+	} else {
+		b.Del(c.Without...)
+	}
+	b.Set(labels.MetricName, c.targetName())
+	return b.Labels()
+}
+
+// Sample is a single scraped (labels, value) pair, as read off the wire
+// before it reaches the TSDB appender.
+type Sample struct {
+	Labels labels.Labels
+	Value  float64
+}
+
+// Apply aggregates samples matching one of rules' MetricName, summing values
+// grouped by the rule's By/Without labels, and appends the resulting
+// aggregate samples. Samples for a rule with DropSource set are removed from
+// the returned slice; all other samples, including those for metrics no
+// rule names, are passed through unchanged. Relative order of samples that
+// are neither aggregated away nor an aggregate is preserved; aggregates are
+// appended at the end in rule order.
+func Apply(samples []Sample, rules []*Config) []Sample {
+	if len(rules) == 0 {
+		return samples
+	}
+
+	byMetric := make(map[string][]*Config)
+	for _, r := range rules {
+		byMetric[r.MetricName] = append(byMetric[r.MetricName], r)
+	}
+
+	type aggKey struct {
+		rule *Config
+		key  string
+	}
+	aggregates := make(map[aggKey]*Sample)
+	var order []aggKey
+
+	out := make([]Sample, 0, len(samples))
+	for _, s := range samples {
+		rs, ok := byMetric[s.Labels.Get(labels.MetricName)]
+		if !ok {
+			out = append(out, s)
+			continue
+		}
+
+		dropSource := false
+		for _, r := range rs {
+			lset := r.groupingLabels(s.Labels)
+			k := aggKey{rule: r, key: lset.String()}
+			agg, ok := aggregates[k]
+			if !ok {
+				agg = &Sample{Labels: lset}
+				aggregates[k] = agg
+				order = append(order, k)
+			}
+			agg.Value += s.Value
+			dropSource = dropSource || r.DropSource
+		}
+		if !dropSource {
+			out = append(out, s)
+		}
+	}
+
+	for _, k := range order {
+		out = append(out, *aggregates[k])
+	}
+	return out
+}
@@ -0,0 +1,108 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scraperules
+
+import (
+	"sort"
+	"testing"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/util/testutil"
+)
+
+func sortSamples(samples []Sample) {
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i].Labels.String() < samples[j].Labels.String()
+	})
+}
+
+func TestApply_Without(t *testing.T) {
+	rules := []*Config{
+		{MetricName: "cpu_seconds_total", Without: []string{"cpu"}, DropSource: true},
+	}
+
+	samples := []Sample{
+		{Labels: labels.FromStrings("__name__", "cpu_seconds_total", "instance", "a", "cpu", "0"), Value: 1},
+		{Labels: labels.FromStrings("__name__", "cpu_seconds_total", "instance", "a", "cpu", "1"), Value: 2},
+		{Labels: labels.FromStrings("__name__", "up", "instance", "a"), Value: 1},
+	}
+
+	got := Apply(samples, rules)
+	sortSamples(got)
+
+	want := []Sample{
+		{Labels: labels.FromStrings("__name__", "cpu_seconds_total", "instance", "a"), Value: 3},
+		{Labels: labels.FromStrings("__name__", "up", "instance", "a"), Value: 1},
+	}
+	sortSamples(want)
+
+	testutil.Equals(t, want, got)
+}
+
+func TestApply_ByAndTargetName(t *testing.T) {
+	rules := []*Config{
+		{MetricName: "cpu_seconds_total", By: []string{"instance"}, TargetMetricName: "cpu_seconds_total:sum", DropSource: false},
+	}
+
+	samples := []Sample{
+		{Labels: labels.FromStrings("__name__", "cpu_seconds_total", "instance", "a", "cpu", "0"), Value: 1},
+		{Labels: labels.FromStrings("__name__", "cpu_seconds_total", "instance", "a", "cpu", "1"), Value: 2},
+	}
+
+	got := Apply(samples, rules)
+	sortSamples(got)
+
+	want := []Sample{
+		{Labels: labels.FromStrings("__name__", "cpu_seconds_total", "instance", "a", "cpu", "0"), Value: 1},
+		{Labels: labels.FromStrings("__name__", "cpu_seconds_total", "instance", "a", "cpu", "1"), Value: 2},
+		{Labels: labels.FromStrings("__name__", "cpu_seconds_total:sum", "instance", "a"), Value: 3},
+	}
+	sortSamples(want)
+
+	testutil.Equals(t, want, got)
+}
+
+func TestApply_NoRules(t *testing.T) {
+	samples := []Sample{
+		{Labels: labels.FromStrings("__name__", "up"), Value: 1},
+	}
+	testutil.Equals(t, samples, Apply(samples, nil))
+}
+
+func TestConfig_UnmarshalYAML(t *testing.T) {
+	var c1 Config
+	err := yaml.Unmarshal([]byte(`
+metric_name: foo
+by: [a]
+without: [b]
+`), &c1)
+	testutil.NotOk(t, err, "expected an error when both by and without are set")
+
+	var c2 Config
+	err = yaml.Unmarshal([]byte(`
+by: [a]
+`), &c2)
+	testutil.NotOk(t, err, "expected an error when metric_name is missing")
+
+	var c3 Config
+	err = yaml.Unmarshal([]byte(`
+metric_name: foo
+without: [cpu]
+`), &c3)
+	testutil.Ok(t, err)
+	testutil.Equals(t, "foo", c3.MetricName)
+	testutil.Equals(t, []string{"cpu"}, c3.Without)
+}
@@ -15,6 +15,7 @@ package textparse
 
 import (
 	"mime"
+	"sync"
 
 	"github.com/prometheus/prometheus/pkg/exemplar"
 	"github.com/prometheus/prometheus/pkg/labels"
@@ -60,11 +61,38 @@ type Parser interface {
 	Next() (Entry, error)
 }
 
+// NewParserFunc returns a Parser that reads samples out of b.
+type NewParserFunc func(b []byte) Parser
+
+var (
+	parserFactoriesMtx sync.RWMutex
+	parserFactories    = map[string]NewParserFunc{}
+)
+
+// RegisterParserFactory makes New return a Parser built by newParser for
+// scrapes whose Content-Type's media type is mediaType, so embedders can add
+// exposition formats (e.g. a custom JSON metrics format) that feed the
+// standard scrape/ingestion pipeline without forking the scrape loop.
+// Registering the same mediaType twice replaces the previous factory.
+func RegisterParserFactory(mediaType string, newParser NewParserFunc) {
+	parserFactoriesMtx.Lock()
+	defer parserFactoriesMtx.Unlock()
+	parserFactories[mediaType] = newParser
+}
+
 // New returns a new parser of the byte slice.
 func New(b []byte, contentType string) Parser {
 	mediaType, _, err := mime.ParseMediaType(contentType)
-	if err == nil && mediaType == "application/openmetrics-text" {
-		return NewOpenMetricsParser(b)
+	if err == nil {
+		parserFactoriesMtx.RLock()
+		newParser, ok := parserFactories[mediaType]
+		parserFactoriesMtx.RUnlock()
+		if ok {
+			return newParser(b)
+		}
+		if mediaType == "application/openmetrics-text" {
+			return NewOpenMetricsParser(b)
+		}
 	}
 	return NewPromParser(b)
 }
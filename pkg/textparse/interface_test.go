@@ -0,0 +1,39 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package textparse
+
+import (
+	"testing"
+
+	"github.com/prometheus/prometheus/util/testutil"
+)
+
+type stubParser struct {
+	Parser
+}
+
+func TestRegisterParserFactory(t *testing.T) {
+	stub := &stubParser{}
+	RegisterParserFactory("application/x-custom-metrics", func(b []byte) Parser {
+		return stub
+	})
+
+	p := New([]byte{}, "application/x-custom-metrics; charset=utf-8")
+	testutil.Equals(t, Parser(stub), p)
+
+	// Unregistered media types still fall back to the built-in parsers.
+	p = New([]byte{}, "application/openmetrics-text")
+	_, ok := p.(*OpenMetricsParser)
+	testutil.Assert(t, ok, "expected an OpenMetricsParser for an unregistered, built-in media type")
+}
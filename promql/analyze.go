@@ -0,0 +1,108 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package promql
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// AnalyzeOutputNode is one node of a query's evaluation-plan tree, as
+// recorded when analysis is requested via NewAnalyzeContext. The tree shape
+// mirrors the parsed expression tree: every PromQL subexpression that passes
+// through evaluator.eval becomes one node, carrying the series it produced,
+// the running total of samples the evaluator had processed by the time it
+// finished, and the wall time spent evaluating it (including its children).
+type AnalyzeOutputNode struct {
+	Name     string               `json:"name"`
+	Series   int                  `json:"series"`
+	Samples  int64                `json:"samples"`
+	Duration time.Duration        `json:"duration"`
+	Children []*AnalyzeOutputNode `json:"children,omitempty"`
+}
+
+// queryAnalysis builds an AnalyzeOutputNode tree as evaluator.eval recurses
+// through a query's expression tree. It is not safe for concurrent use by
+// multiple evaluators; the aggregationConcurrency code path does not record
+// per-shard analysis.
+type queryAnalysis struct {
+	root  *AnalyzeOutputNode
+	stack []*AnalyzeOutputNode
+}
+
+// enter starts a new node named name, nesting it under whichever node is
+// currently on top of the stack, and pushes it as the new top.
+func (a *queryAnalysis) enter(name string) *AnalyzeOutputNode {
+	n := &AnalyzeOutputNode{Name: name}
+	if len(a.stack) == 0 {
+		a.root = n
+	} else {
+		parent := a.stack[len(a.stack)-1]
+		parent.Children = append(parent.Children, n)
+	}
+	a.stack = append(a.stack, n)
+	return n
+}
+
+// leave records n's stats and pops it off the stack.
+func (a *queryAnalysis) leave(n *AnalyzeOutputNode, d time.Duration, samples int64, series int) {
+	n.Duration = d
+	n.Samples = samples
+	n.Series = series
+	a.stack = a.stack[:len(a.stack)-1]
+}
+
+// analyzeContextKey is the context key under which NewAnalyzeContext stashes
+// its marker value, mirroring queryOrigin's use of context.WithValue to pass
+// side-channel data into query execution.
+type analyzeContextKey struct{}
+
+// NewAnalyzeContext returns a new context that requests an evaluation-plan
+// tree for any query executed with it. The tree can be read back afterwards
+// via Query.Analyze.
+func NewAnalyzeContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, analyzeContextKey{}, true)
+}
+
+// nodeName returns a short, human-readable label for expr's own contribution
+// to the query, without recursing into its children -- the tree structure
+// already conveys nesting.
+func nodeName(expr parser.Expr) string {
+	switch e := expr.(type) {
+	case *parser.AggregateExpr:
+		return e.Op.String()
+	case *parser.BinaryExpr:
+		return "binary expr (" + e.Op.String() + ")"
+	case *parser.Call:
+		return e.Func.Name + "()"
+	case *parser.MatrixSelector:
+		return "matrix selector"
+	case *parser.SubqueryExpr:
+		return "subquery"
+	case *parser.NumberLiteral:
+		return "number literal"
+	case *parser.ParenExpr:
+		return "paren expr"
+	case *parser.StringLiteral:
+		return "string literal"
+	case *parser.UnaryExpr:
+		return "unary expr (" + e.Op.String() + ")"
+	case *parser.VectorSelector:
+		return "vector selector"
+	default:
+		return expr.String()
+	}
+}
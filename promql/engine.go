@@ -95,6 +95,10 @@ type (
 	ErrQueryCanceled string
 	// ErrTooManySamples is returned if a query would load more than the maximum allowed samples into memory.
 	ErrTooManySamples string
+	// ErrTooManySampleBytes is returned if a query's samples -- label set
+	// bytes plus per-point overhead -- would exceed the configured memory
+	// budget, a tighter proxy for actual memory use than ErrTooManySamples.
+	ErrTooManySampleBytes string
 	// ErrStorage is returned if an error was encountered in the storage layer
 	// during query handling.
 	ErrStorage struct{ Err error }
@@ -109,6 +113,9 @@ func (e ErrQueryCanceled) Error() string {
 func (e ErrTooManySamples) Error() string {
 	return fmt.Sprintf("query processing would load too many samples into memory in %s", string(e))
 }
+func (e ErrTooManySampleBytes) Error() string {
+	return fmt.Sprintf("query processing would use too much memory in %s", string(e))
+}
 func (e ErrStorage) Error() string {
 	return e.Err.Error()
 }
@@ -133,6 +140,10 @@ type Query interface {
 	Stats() *stats.QueryTimers
 	// Cancel signals that a running query execution should be aborted.
 	Cancel()
+	// Analyze returns the evaluation-plan tree recorded for the query, or
+	// nil if it was not executed with a context created by
+	// NewAnalyzeContext.
+	Analyze() *AnalyzeOutputNode
 }
 
 // query implements the Query interface.
@@ -149,6 +160,9 @@ type query struct {
 	matrix Matrix
 	// Cancellation function for the query.
 	cancel func()
+	// Evaluation-plan tree, set if the query was executed with a context
+	// created by NewAnalyzeContext.
+	analysis *AnalyzeOutputNode
 
 	// The engine against which the query is executed.
 	ng *Engine
@@ -173,6 +187,11 @@ func (q *query) Cancel() {
 	}
 }
 
+// Analyze implements the Query interface.
+func (q *query) Analyze() *AnalyzeOutputNode {
+	return q.analysis
+}
+
 // Close implements the Query interface.
 func (q *query) Close() {
 	for _, s := range q.matrix {
@@ -221,19 +240,32 @@ type EngineOpts struct {
 	// LookbackDelta determines the time since the last sample after which a time
 	// series is considered stale.
 	LookbackDelta time.Duration
+	// AggregationConcurrency sets the number of goroutines that may be used to
+	// evaluate a single grouping aggregation (sum, avg, topk, ...) in parallel.
+	// Values <= 1 evaluate aggregations on the calling goroutine, as before.
+	AggregationConcurrency int
+	// MaxSampleBytes bounds the estimated memory, in bytes, that the samples
+	// held by a single query's intermediate matrices may occupy -- label set
+	// bytes (see labels.Labels.ByteSize) plus a fixed per-point cost. This is
+	// a more faithful proxy for actual memory use than MaxSamples alone,
+	// which counts every sample the same regardless of how large its label
+	// set is. Values <= 0 disable the check.
+	MaxSampleBytes int64
 }
 
 // Engine handles the lifetime of queries from beginning to end.
 // It is connected to a querier.
 type Engine struct {
-	logger             log.Logger
-	metrics            *engineMetrics
-	timeout            time.Duration
-	maxSamplesPerQuery int
-	activeQueryTracker *ActiveQueryTracker
-	queryLogger        QueryLogger
-	queryLoggerLock    sync.RWMutex
-	lookbackDelta      time.Duration
+	logger                 log.Logger
+	metrics                *engineMetrics
+	timeout                time.Duration
+	maxSamplesPerQuery     int
+	activeQueryTracker     *ActiveQueryTracker
+	queryLogger            QueryLogger
+	queryLoggerLock        sync.RWMutex
+	lookbackDelta          time.Duration
+	aggregationConcurrency int
+	maxSampleBytes         int64
 }
 
 // NewEngine returns a new engine.
@@ -328,12 +360,14 @@ func NewEngine(opts EngineOpts) *Engine {
 	}
 
 	return &Engine{
-		timeout:            opts.Timeout,
-		logger:             opts.Logger,
-		metrics:            metrics,
-		maxSamplesPerQuery: opts.MaxSamples,
-		activeQueryTracker: opts.ActiveQueryTracker,
-		lookbackDelta:      opts.LookbackDelta,
+		timeout:                opts.Timeout,
+		logger:                 opts.Logger,
+		metrics:                metrics,
+		maxSamplesPerQuery:     opts.MaxSamples,
+		activeQueryTracker:     opts.ActiveQueryTracker,
+		lookbackDelta:          opts.LookbackDelta,
+		aggregationConcurrency: opts.AggregationConcurrency,
+		maxSampleBytes:         opts.MaxSampleBytes,
 	}
 }
 
@@ -389,6 +423,7 @@ func (ng *Engine) NewRangeQuery(q storage.Queryable, qs string, start, end time.
 }
 
 func (ng *Engine) newQuery(q storage.Queryable, expr parser.Expr, start, end time.Time, interval time.Duration) *query {
+	resolveAtModifiers(expr, start, end)
 	es := &parser.EvalStmt{
 		Expr:     expr,
 		Start:    start,
@@ -404,6 +439,28 @@ func (ng *Engine) newQuery(q storage.Queryable, expr parser.Expr, start, end tim
 	return qry
 }
 
+// resolveAtModifiers turns the `@ start()` / `@ end()` forms of the `@`
+// modifier into a fixed Timestamp, now that the query's evaluation range is
+// known. `@ <unix timestamp>` selectors already have a Timestamp set by the
+// parser and are left untouched.
+func resolveAtModifiers(expr parser.Expr, start, end time.Time) {
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		vs, ok := node.(*parser.VectorSelector)
+		if !ok {
+			return nil
+		}
+		switch vs.StartOrEnd {
+		case parser.StartModifier:
+			ts := timestamp.FromTime(start)
+			vs.Timestamp = &ts
+		case parser.EndModifier:
+			ts := timestamp.FromTime(end)
+			vs.Timestamp = &ts
+		}
+		return nil
+	})
+}
+
 func (ng *Engine) newTestQuery(f func(context.Context) error) Query {
 	qry := &query{
 		q:     "test statement",
@@ -510,7 +567,14 @@ func durationMilliseconds(d time.Duration) int64 {
 func (ng *Engine) execEvalStmt(ctx context.Context, query *query, s *parser.EvalStmt) (parser.Value, storage.Warnings, error) {
 	prepareSpanTimer, ctxPrepare := query.stats.GetSpanTimer(ctx, stats.QueryPreparationTime, ng.metrics.queryPrepareTime)
 	mint := ng.findMinTime(s)
-	querier, err := query.queryable.Querier(ctxPrepare, timestamp.FromTime(mint), timestamp.FromTime(s.End))
+	maxt := s.End
+	if _, atMax, ok := atModifierTimeBounds(s.Expr, ng.lookbackDelta); ok && atMax.After(maxt) {
+		maxt = atMax
+	}
+	if negMax, ok := negativeOffsetMaxTime(s.Expr, s.End); ok && negMax.After(maxt) {
+		maxt = negMax
+	}
+	querier, err := query.queryable.Querier(ctxPrepare, timestamp.FromTime(mint), timestamp.FromTime(maxt))
 	if err != nil {
 		prepareSpanTimer.Finish()
 		return nil, nil, err
@@ -520,22 +584,33 @@ func (ng *Engine) execEvalStmt(ctx context.Context, query *query, s *parser.Eval
 	ng.populateSeries(querier, s)
 	prepareSpanTimer.Finish()
 
+	var analysis *queryAnalysis
+	if ctx.Value(analyzeContextKey{}) != nil {
+		analysis = &queryAnalysis{}
+	}
+
 	evalSpanTimer, ctxInnerEval := query.stats.GetSpanTimer(ctx, stats.InnerEvalTime, ng.metrics.queryInnerEval)
 	// Instant evaluation. This is executed as a range evaluation with one step.
 	if s.Start == s.End && s.Interval == 0 {
 		start := timeMilliseconds(s.Start)
 		evaluator := &evaluator{
-			startTimestamp:      start,
-			endTimestamp:        start,
-			interval:            1,
-			ctx:                 ctxInnerEval,
-			maxSamples:          ng.maxSamplesPerQuery,
-			defaultEvalInterval: GetDefaultEvaluationInterval(),
-			logger:              ng.logger,
-			lookbackDelta:       ng.lookbackDelta,
+			startTimestamp:         start,
+			endTimestamp:           start,
+			interval:               1,
+			ctx:                    ctxInnerEval,
+			maxSamples:             ng.maxSamplesPerQuery,
+			defaultEvalInterval:    GetDefaultEvaluationInterval(),
+			logger:                 ng.logger,
+			lookbackDelta:          ng.lookbackDelta,
+			aggregationConcurrency: ng.aggregationConcurrency,
+			maxSampleBytes:         ng.maxSampleBytes,
+			analyze:                analysis,
 		}
 
 		val, warnings, err := evaluator.Eval(s.Expr)
+		if analysis != nil {
+			query.analysis = analysis.root
+		}
 		if err != nil {
 			return nil, warnings, err
 		}
@@ -575,16 +650,22 @@ func (ng *Engine) execEvalStmt(ctx context.Context, query *query, s *parser.Eval
 
 	// Range evaluation.
 	evaluator := &evaluator{
-		startTimestamp:      timeMilliseconds(s.Start),
-		endTimestamp:        timeMilliseconds(s.End),
-		interval:            durationMilliseconds(s.Interval),
-		ctx:                 ctxInnerEval,
-		maxSamples:          ng.maxSamplesPerQuery,
-		defaultEvalInterval: GetDefaultEvaluationInterval(),
-		logger:              ng.logger,
-		lookbackDelta:       ng.lookbackDelta,
+		startTimestamp:         timeMilliseconds(s.Start),
+		endTimestamp:           timeMilliseconds(s.End),
+		interval:               durationMilliseconds(s.Interval),
+		ctx:                    ctxInnerEval,
+		maxSamples:             ng.maxSamplesPerQuery,
+		defaultEvalInterval:    GetDefaultEvaluationInterval(),
+		logger:                 ng.logger,
+		lookbackDelta:          ng.lookbackDelta,
+		aggregationConcurrency: ng.aggregationConcurrency,
+		maxSampleBytes:         ng.maxSampleBytes,
+		analyze:                analysis,
 	}
 	val, warnings, err := evaluator.Eval(s.Expr)
+	if analysis != nil {
+		query.analysis = analysis.root
+	}
 	if err != nil {
 		return nil, warnings, err
 	}
@@ -642,7 +723,77 @@ func (ng *Engine) findMinTime(s *parser.EvalStmt) time.Time {
 		}
 		return nil
 	})
-	return s.Start.Add(-maxOffset)
+	mint := s.Start.Add(-maxOffset)
+	if atMin, _, ok := atModifierTimeBounds(s.Expr, ng.lookbackDelta); ok && atMin.Before(mint) {
+		mint = atMin
+	}
+	return mint
+}
+
+// atModifierTimeBounds returns the earliest and latest time that selectors
+// pinned via the `@` modifier (see VectorSelector.Timestamp) may need data
+// for, so that the querier created for the query covers them even if they
+// fall outside of the query's own evaluation range.
+func atModifierTimeBounds(expr parser.Expr, lookbackDelta time.Duration) (min, max time.Time, ok bool) {
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		var (
+			ts    *int64
+			rng   time.Duration
+			offst time.Duration
+		)
+		switch n := node.(type) {
+		case *parser.VectorSelector:
+			ts, offst = n.Timestamp, n.Offset
+		case *parser.MatrixSelector:
+			vs := n.VectorSelector.(*parser.VectorSelector)
+			ts, offst, rng = vs.Timestamp, vs.Offset, n.Range
+		default:
+			return nil
+		}
+		if ts == nil {
+			return nil
+		}
+		t := timestamp.Time(*ts - durationMilliseconds(offst))
+		lo := t.Add(-lookbackDelta - rng)
+		if !ok || lo.Before(min) {
+			min = lo
+		}
+		if !ok || t.After(max) {
+			max = t
+		}
+		ok = true
+		return nil
+	})
+	return min, max, ok
+}
+
+// negativeOffsetMaxTime returns the latest time data may be needed at by any
+// selector with a negative offset (see negativeoffset.go): such a selector
+// reads data from after the query's own evaluation time, so the querier
+// created for the query must cover that too even though it falls outside of
+// [s.Start, s.End].
+func negativeOffsetMaxTime(expr parser.Expr, end time.Time) (max time.Time, ok bool) {
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		var offst time.Duration
+		switch n := node.(type) {
+		case *parser.VectorSelector:
+			offst = n.Offset
+		case *parser.MatrixSelector:
+			offst = n.VectorSelector.(*parser.VectorSelector).Offset
+		default:
+			return nil
+		}
+		if offst >= 0 {
+			return nil
+		}
+		t := end.Add(-offst)
+		if !ok || t.After(max) {
+			max = t
+		}
+		ok = true
+		return nil
+	})
+	return max, ok
 }
 
 func (ng *Engine) populateSeries(querier storage.Querier, s *parser.EvalStmt) {
@@ -654,9 +805,13 @@ func (ng *Engine) populateSeries(querier storage.Querier, s *parser.EvalStmt) {
 	parser.Inspect(s.Expr, func(node parser.Node, path []parser.Node) error {
 		switch n := node.(type) {
 		case *parser.VectorSelector:
+			start, end := timestamp.FromTime(s.Start), timestamp.FromTime(s.End)
+			if n.Timestamp != nil {
+				start, end = *n.Timestamp, *n.Timestamp
+			}
 			hints := &storage.SelectHints{
-				Start: timestamp.FromTime(s.Start),
-				End:   timestamp.FromTime(s.End),
+				Start: start,
+				End:   end,
 				Step:  durationToInt64Millis(s.Interval),
 			}
 
@@ -680,7 +835,7 @@ func (ng *Engine) populateSeries(querier storage.Querier, s *parser.EvalStmt) {
 
 			hints.Func = extractFuncFromPath(path)
 			hints.By, hints.Grouping = extractGroupsFromPath(path)
-			if n.Offset > 0 {
+			if n.Offset != 0 {
 				offsetMilliseconds := durationMilliseconds(n.Offset)
 				hints.Start = hints.Start - offsetMilliseconds
 				hints.End = hints.End - offsetMilliseconds
@@ -774,6 +929,58 @@ type evaluator struct {
 	defaultEvalInterval int64
 	logger              log.Logger
 	lookbackDelta       time.Duration
+	// aggregationConcurrency is the number of goroutines the evaluator may
+	// use to shard a single grouping aggregation across. <= 1 means
+	// aggregations are evaluated on the calling goroutine.
+	aggregationConcurrency int
+	// maxSampleBytes and currentSampleBytes are the byte-level counterpart of
+	// maxSamples/currentSamples: an estimate, in bytes, of the label sets and
+	// points held by the query's intermediate matrices. maxSampleBytes <= 0
+	// disables the check.
+	maxSampleBytes     int64
+	currentSampleBytes int64
+	// analyze records the evaluation-plan tree for this query's eval calls.
+	// nil unless the query was executed with a context created by
+	// NewAnalyzeContext.
+	analyze *queryAnalysis
+}
+
+// pointBytes is the fixed per-sample cost counted by addSampleBytes, on top
+// of the label set's own labels.Labels.ByteSize(): the 8-byte timestamp and
+// 8-byte value of a single Point.
+const pointBytes = 16
+
+// addSampleBytes adds the estimated footprint of numPoints points belonging
+// to lbls -- lbls.ByteSize() once, plus pointBytes per point -- to the
+// evaluator's running total, aborting the query once maxSampleBytes is
+// exceeded.
+func (ev *evaluator) addSampleBytes(lbls labels.Labels, numPoints int) {
+	if ev.maxSampleBytes <= 0 {
+		return
+	}
+	ev.currentSampleBytes += int64(lbls.ByteSize()) + int64(numPoints)*pointBytes
+	if ev.currentSampleBytes > ev.maxSampleBytes {
+		ev.error(ErrTooManySampleBytes(env))
+	}
+}
+
+// matrixBytes estimates the total footprint of mat: the label set bytes of
+// each of its series, plus pointBytes per point.
+func matrixBytes(mat Matrix) int64 {
+	var b int64
+	for _, s := range mat {
+		b += int64(s.Metric.ByteSize()) + int64(len(s.Points))*pointBytes
+	}
+	return b
+}
+
+// checkSampleBytes aborts the query if ev.currentSampleBytes has exceeded
+// ev.maxSampleBytes. Unlike addSampleBytes, it does not itself update
+// currentSampleBytes, for callers that set it directly from matrixBytes.
+func (ev *evaluator) checkSampleBytes() {
+	if ev.maxSampleBytes > 0 && ev.currentSampleBytes > ev.maxSampleBytes {
+		ev.error(ErrTooManySampleBytes(env))
+	}
 }
 
 // errorf causes a panic with the input formatted into an error.
@@ -884,6 +1091,7 @@ func (ev *evaluator) rangeEval(f func([]parser.Value, *EvalNodeHelper) (Vector,
 	matrixes := make([]Matrix, len(exprs))
 	origMatrixes := make([]Matrix, len(exprs))
 	originalNumSamples := ev.currentSamples
+	originalNumBytes := ev.currentSampleBytes
 
 	var warnings storage.Warnings
 	for i, e := range exprs {
@@ -968,6 +1176,8 @@ func (ev *evaluator) rangeEval(f func([]parser.Value, *EvalNodeHelper) (Vector,
 				mat[i] = Series{Metric: s.Metric, Points: []Point{s.Point}}
 			}
 			ev.currentSamples = originalNumSamples + mat.TotalSamples()
+			ev.currentSampleBytes = originalNumBytes + matrixBytes(mat)
+			ev.checkSampleBytes()
 			return mat, warnings
 		}
 
@@ -985,6 +1195,19 @@ func (ev *evaluator) rangeEval(f func([]parser.Value, *EvalNodeHelper) (Vector,
 			ss.Points = append(ss.Points, sample.Point)
 			seriess[h] = ss
 
+			// Bound currentSampleBytes as the step's samples are produced,
+			// rather than only once the whole output matrix has been
+			// assembled, so a multi-step range query aborts instead of
+			// fully materializing a result that exceeds maxSampleBytes.
+			// Charge a series' label-set bytes only the first time it is
+			// seen -- it is the same backing Labels for every step -- and
+			// just the per-point cost on every later step.
+			if !ok {
+				ev.addSampleBytes(sample.Metric, 1)
+			} else if ev.maxSampleBytes > 0 {
+				ev.currentSampleBytes += pointBytes
+				ev.checkSampleBytes()
+			}
 		}
 	}
 
@@ -1000,6 +1223,8 @@ func (ev *evaluator) rangeEval(f func([]parser.Value, *EvalNodeHelper) (Vector,
 		mat = append(mat, ss)
 	}
 	ev.currentSamples = originalNumSamples + mat.TotalSamples()
+	ev.currentSampleBytes = originalNumBytes + matrixBytes(mat)
+	ev.checkSampleBytes()
 	return mat, warnings
 }
 
@@ -1023,7 +1248,7 @@ func (ev *evaluator) evalSubquery(subq *parser.SubqueryExpr) (*parser.MatrixSele
 }
 
 // eval evaluates the given expression as the given AST expression node requires.
-func (ev *evaluator) eval(expr parser.Expr) (parser.Value, storage.Warnings) {
+func (ev *evaluator) eval(expr parser.Expr) (retVal parser.Value, retWs storage.Warnings) {
 	// This is the top-level evaluation method.
 	// Thus, we check for timeout/cancellation here.
 	if err := contextDone(ev.ctx, "expression evaluation"); err != nil {
@@ -1031,20 +1256,43 @@ func (ev *evaluator) eval(expr parser.Expr) (parser.Value, storage.Warnings) {
 	}
 	numSteps := int((ev.endTimestamp-ev.startTimestamp)/ev.interval) + 1
 
+	if ev.analyze != nil {
+		node := ev.analyze.enter(nodeName(expr))
+		start := time.Now()
+		defer func() {
+			series := 0
+			switch r := retVal.(type) {
+			case Matrix:
+				series = len(r)
+			case Vector:
+				series = len(r)
+			}
+			ev.analyze.leave(node, time.Since(start), int64(ev.currentSamples), series)
+		}()
+	}
+
 	switch e := expr.(type) {
 	case *parser.AggregateExpr:
 		unwrapParenExpr(&e.Param)
 		if s, ok := e.Param.(*parser.StringLiteral); ok {
 			return ev.rangeEval(func(v []parser.Value, enh *EvalNodeHelper) (Vector, storage.Warnings) {
-				return ev.aggregation(e.Op, e.Grouping, e.Without, s.Val, v[0].(Vector), enh), nil
+				return ev.aggregation(e.Op, e.Grouping, e.GroupingPatterns, e.Without, s.Val, v[0].(Vector), enh), nil
 			}, e.Expr)
 		}
 		return ev.rangeEval(func(v []parser.Value, enh *EvalNodeHelper) (Vector, storage.Warnings) {
-			var param float64
+			var param interface{}
 			if e.Param != nil {
-				param = v[0].(Vector)[0].V
+				if e.Op == parser.TOPK || e.Op == parser.BOTTOMK {
+					// topk/bottomk allow their k parameter to be the result of
+					// an aggregated expression with its own grouping, e.g.
+					// topk(count(up) by (job), up) by (job). Keep the whole
+					// Vector so the per-group k can be looked up below.
+					param = v[0].(Vector)
+				} else {
+					param = v[0].(Vector)[0].V
+				}
 			}
-			return ev.aggregation(e.Op, e.Grouping, e.Without, param, v[1].(Vector), enh), nil
+			return ev.aggregation(e.Op, e.Grouping, e.GroupingPatterns, e.Without, param, v[1].(Vector), enh), nil
 		}, e.Param, e.Expr)
 
 	case *parser.Call:
@@ -1063,6 +1311,25 @@ func (ev *evaluator) eval(expr parser.Expr) (parser.Value, storage.Warnings) {
 			}
 		}
 
+		if e.Func.Name == "absent" {
+			// absent() only cares whether its argument's vector is empty, so
+			// stop decoding series as soon as one match is found instead of
+			// resolving every series the selector matched. This is the common
+			// case for meta-monitoring rules like absent(up{job="..."}), where
+			// the selector can match many series that all turn out to exist.
+			vs, ok := e.Args[0].(*parser.VectorSelector)
+			if ok {
+				return ev.rangeEval(func(v []parser.Value, enh *EvalNodeHelper) (Vector, storage.Warnings) {
+					exists, ws := ev.vectorSelectorExists(vs, enh.ts)
+					var val Vector
+					if exists {
+						val = Vector{Sample{}}
+					}
+					return call([]parser.Value{val}, e.Args, enh), ws
+				})
+			}
+		}
+
 		// Check if the function has a matrix argument.
 		var (
 			matrixArgIndex int
@@ -1124,6 +1391,7 @@ func (ev *evaluator) eval(expr parser.Expr) (parser.Value, storage.Warnings) {
 		if stepRange > ev.interval {
 			stepRange = ev.interval
 		}
+		rangeWarned := false
 		// Reuse objects across steps to save memory allocations.
 		points := getPointSlice(16)
 		inMatrix := make(Matrix, 1)
@@ -1153,15 +1421,28 @@ func (ev *evaluator) eval(expr parser.Expr) (parser.Value, storage.Warnings) {
 						otherInArgs[j][0].V = otherArgs[j][0].Points[step].V
 					}
 				}
-				maxt := ts - offset
+				selTs := ts
+				if selVS.Timestamp != nil {
+					selTs = *selVS.Timestamp
+				}
+				maxt := selTs - offset
 				mint := maxt - selRange
 				// Evaluate the matrix selector for this series for this step.
 				points = ev.matrixIterSlice(it, mint, maxt, points)
 				if len(points) == 0 {
 					continue
 				}
+				if !rangeWarned {
+					if iv, ok := detectedScrapeInterval(points); ok && selRange < 2*iv {
+						warnings = append(warnings, errors.Errorf(
+							"range selector %s is smaller than twice the %s scrape interval detected for %s; rates and similar functions may be noisy or return no data for some steps",
+							sel.Range, time.Duration(iv)*time.Millisecond, ss.Metric,
+						))
+						rangeWarned = true
+					}
+				}
 				inMatrix[0].Points = points
-				enh.ts = ts
+				enh.ts = selTs
 				// Make the function call.
 				outVec := call(inArgs, e.Args, enh)
 				enh.out = outVec[:0]
@@ -1175,6 +1456,7 @@ func (ev *evaluator) eval(expr parser.Expr) (parser.Value, storage.Warnings) {
 				if ev.currentSamples < ev.maxSamples {
 					mat = append(mat, ss)
 					ev.currentSamples += len(ss.Points)
+					ev.addSampleBytes(ss.Metric, len(ss.Points))
 				} else {
 					ev.error(ErrTooManySamples(env))
 				}
@@ -1320,6 +1602,7 @@ func (ev *evaluator) eval(expr parser.Expr) (parser.Value, storage.Warnings) {
 
 			if len(ss.Points) > 0 {
 				mat = append(mat, ss)
+				ev.addSampleBytes(ss.Metric, len(ss.Points))
 			} else {
 				putPointSlice(ss.Points)
 			}
@@ -1336,14 +1619,18 @@ func (ev *evaluator) eval(expr parser.Expr) (parser.Value, storage.Warnings) {
 		offsetMillis := durationToInt64Millis(e.Offset)
 		rangeMillis := durationToInt64Millis(e.Range)
 		newEv := &evaluator{
-			endTimestamp:        ev.endTimestamp - offsetMillis,
-			interval:            ev.defaultEvalInterval,
-			ctx:                 ev.ctx,
-			currentSamples:      ev.currentSamples,
-			maxSamples:          ev.maxSamples,
-			defaultEvalInterval: ev.defaultEvalInterval,
-			logger:              ev.logger,
-			lookbackDelta:       ev.lookbackDelta,
+			endTimestamp:           ev.endTimestamp - offsetMillis,
+			interval:               ev.defaultEvalInterval,
+			ctx:                    ev.ctx,
+			currentSamples:         ev.currentSamples,
+			maxSamples:             ev.maxSamples,
+			defaultEvalInterval:    ev.defaultEvalInterval,
+			logger:                 ev.logger,
+			lookbackDelta:          ev.lookbackDelta,
+			aggregationConcurrency: ev.aggregationConcurrency,
+			currentSampleBytes:     ev.currentSampleBytes,
+			maxSampleBytes:         ev.maxSampleBytes,
+			analyze:                ev.analyze,
 		}
 
 		if e.Step != 0 {
@@ -1359,6 +1646,7 @@ func (ev *evaluator) eval(expr parser.Expr) (parser.Value, storage.Warnings) {
 
 		res, ws := newEv.eval(e.Expr)
 		ev.currentSamples = newEv.currentSamples
+		ev.currentSampleBytes = newEv.currentSampleBytes
 		return res, ws
 	case *parser.StringLiteral:
 		return String{V: e.Val, T: ev.startTimestamp}, nil
@@ -1384,11 +1672,13 @@ func (ev *evaluator) vectorSelector(node *parser.VectorSelector, ts int64) (Vect
 
 		t, v, ok := ev.vectorSelectorSingle(it, node, ts)
 		if ok {
+			lbls := node.Series[i].Labels()
 			vec = append(vec, Sample{
-				Metric: node.Series[i].Labels(),
+				Metric: lbls,
 				Point:  Point{V: v, T: t},
 			})
 			ev.currentSamples++
+			ev.addSampleBytes(lbls, 1)
 		}
 
 		if ev.currentSamples >= ev.maxSamples {
@@ -1398,8 +1688,36 @@ func (ev *evaluator) vectorSelector(node *parser.VectorSelector, ts int64) (Vect
 	return vec, ws
 }
 
+// vectorSelectorExists reports whether node's selector has a sample at ts in
+// any of its matched series, stopping at the first one found. Unlike
+// vectorSelector, it does not decode the remaining matched series once an
+// existing sample has been found, since its only caller, absent(), cares
+// about presence and not which series or value it came from.
+func (ev *evaluator) vectorSelectorExists(node *parser.VectorSelector, ts int64) (bool, storage.Warnings) {
+	ws, err := checkAndExpandSeriesSet(ev.ctx, node)
+	if err != nil {
+		ev.error(errWithWarnings{errors.Wrap(err, "expanding series"), ws})
+	}
+	it := storage.NewBuffer(durationMilliseconds(ev.lookbackDelta))
+	for _, s := range node.Series {
+		it.Reset(s.Iterator())
+		if _, _, ok := ev.vectorSelectorSingle(it, node, ts); ok {
+			ev.currentSamples++
+			ev.addSampleBytes(s.Labels(), 1)
+			if ev.currentSamples >= ev.maxSamples {
+				ev.error(ErrTooManySamples(env))
+			}
+			return true, ws
+		}
+	}
+	return false, ws
+}
+
 // vectorSelectorSingle evaluates a instant vector for the iterator of one time series.
 func (ev *evaluator) vectorSelectorSingle(it *storage.BufferedSeriesIterator, node *parser.VectorSelector, ts int64) (int64, float64, bool) {
+	if node.Timestamp != nil {
+		ts = *node.Timestamp
+	}
 	refTime := ts - durationMilliseconds(node.Offset)
 	var t int64
 	var v float64
@@ -1447,18 +1765,25 @@ func (ev *evaluator) matrixSelector(node *parser.MatrixSelector) (Matrix, storag
 	var (
 		vs = node.VectorSelector.(*parser.VectorSelector)
 
-		offset = durationMilliseconds(vs.Offset)
-		maxt   = ev.startTimestamp - offset
-		mint   = maxt - durationMilliseconds(node.Range)
-		matrix = make(Matrix, 0, len(vs.Series))
+		ts      = ev.startTimestamp
+		offset  = durationMilliseconds(vs.Offset)
+		rangeMs = durationMilliseconds(node.Range)
+		matrix  = make(Matrix, 0, len(vs.Series))
 
 		it = storage.NewBuffer(durationMilliseconds(node.Range))
 	)
+	if vs.Timestamp != nil {
+		ts = *vs.Timestamp
+	}
+	maxt := ts - offset
+	mint := maxt - rangeMs
+
 	ws, err := checkAndExpandSeriesSet(ev.ctx, node)
 	if err != nil {
 		ev.error(errWithWarnings{errors.Wrap(err, "expanding series"), ws})
 	}
 
+	rangeWarned := false
 	series := vs.Series
 	for i, s := range series {
 		if err := contextDone(ev.ctx, "expression evaluation"); err != nil {
@@ -1473,6 +1798,15 @@ func (ev *evaluator) matrixSelector(node *parser.MatrixSelector) (Matrix, storag
 
 		if len(ss.Points) > 0 {
 			matrix = append(matrix, ss)
+			if !rangeWarned {
+				if iv, ok := detectedScrapeInterval(ss.Points); ok && rangeMs < 2*iv {
+					ws = append(ws, errors.Errorf(
+						"range selector %s is smaller than twice the %s scrape interval detected for %s; rates and similar functions may be noisy or return no data for some steps",
+						node.Range, time.Duration(iv)*time.Millisecond, ss.Metric,
+					))
+					rangeWarned = true
+				}
+			}
 		} else {
 			putPointSlice(ss.Points)
 		}
@@ -1480,6 +1814,25 @@ func (ev *evaluator) matrixSelector(node *parser.MatrixSelector) (Matrix, storag
 	return matrix, ws
 }
 
+// detectedScrapeInterval estimates a series' scrape interval as the smallest
+// gap between consecutive samples in pts, which is resilient to occasional
+// missed scrapes (those only ever widen the gap, never narrow it).
+func detectedScrapeInterval(pts []Point) (int64, bool) {
+	if len(pts) < 2 {
+		return 0, false
+	}
+	min := pts[1].T - pts[0].T
+	for i := 2; i < len(pts); i++ {
+		if d := pts[i].T - pts[i-1].T; d < min {
+			min = d
+		}
+	}
+	if min <= 0 {
+		return 0, false
+	}
+	return min, true
+}
+
 // matrixIterSlice populates a matrix vector covering the requested range for a
 // single time series, with points retrieved from an iterator.
 //
@@ -1895,20 +2248,13 @@ type groupedAggregation struct {
 }
 
 // aggregation evaluates an aggregation operation on a Vector.
-func (ev *evaluator) aggregation(op parser.ItemType, grouping []string, without bool, param interface{}, vec Vector, enh *EvalNodeHelper) Vector {
+func (ev *evaluator) aggregation(op parser.ItemType, grouping []string, groupingPatterns []string, without bool, param interface{}, vec Vector, enh *EvalNodeHelper) Vector {
+	if len(groupingPatterns) > 0 {
+		grouping = resolveGroupingPatterns(grouping, groupingPatterns, vec)
+	}
 
 	result := map[uint64]*groupedAggregation{}
 	var k int64
-	if op == parser.TOPK || op == parser.BOTTOMK {
-		f := param.(float64)
-		if !convertibleToInt64(f) {
-			ev.errorf("Scalar value %v overflows int64", f)
-		}
-		k = int64(f)
-		if k < 1 {
-			return Vector{}
-		}
-	}
 	var q float64
 	if op == parser.QUANTILE {
 		q = param.(float64)
@@ -1925,9 +2271,124 @@ func (ev *evaluator) aggregation(op parser.ItemType, grouping []string, without
 	}
 
 	sort.Strings(grouping)
+
+	// kHash holds a per-group k value when the topk/bottomk parameter is
+	// itself an aggregated Vector (e.g. topk(count(up) by (job), up) by
+	// (job)) rather than a plain scalar. Each entry is keyed by the same
+	// grouping hash used for vec below, so it only applies when the
+	// parameter's grouping lines up with the aggregation's own grouping.
+	var kHash map[uint64]int64
+	if op == parser.TOPK || op == parser.BOTTOMK {
+		switch p := param.(type) {
+		case Vector:
+			if len(p) == 0 {
+				return Vector{}
+			}
+			kHash = make(map[uint64]int64, len(p))
+			kbuf := make([]byte, 0, 1024)
+			for _, ks := range p {
+				f := ks.V
+				if !convertibleToInt64(f) {
+					ev.errorf("Scalar value %v overflows int64", f)
+				}
+				var gk uint64
+				if without {
+					gk, kbuf = ks.Metric.HashWithoutLabels(kbuf, grouping...)
+				} else {
+					gk, kbuf = ks.Metric.HashForLabels(kbuf, grouping...)
+				}
+				kHash[gk] = int64(f)
+				if int64(f) > k {
+					k = int64(f)
+				}
+			}
+		case float64:
+			if !convertibleToInt64(p) {
+				ev.errorf("Scalar value %v overflows int64", p)
+			}
+			k = int64(p)
+			if k < 1 {
+				return Vector{}
+			}
+		}
+	}
+
+	shards := ev.aggregationConcurrency
+	if shards < 2 || len(vec) < minSeriesPerAggregationShard*shards {
+		result = ev.groupSamples(op, grouping, without, valueLabel, k, kHash, len(vec), vec)
+	} else {
+		result = ev.groupSamplesSharded(op, grouping, without, valueLabel, k, kHash, vec, shards)
+	}
+
+	// Construct the result Vector from the aggregated groups.
+	for _, aggr := range result {
+		switch op {
+		case parser.AVG:
+			aggr.value = aggr.mean
+
+		case parser.COUNT, parser.COUNT_VALUES:
+			aggr.value = float64(aggr.groupCount)
+
+		case parser.STDVAR:
+			aggr.value = aggr.value / float64(aggr.groupCount)
+
+		case parser.STDDEV:
+			aggr.value = math.Sqrt(aggr.value / float64(aggr.groupCount))
+
+		case parser.TOPK:
+			// The heap keeps the lowest value on top, so reverse it.
+			sort.Sort(sort.Reverse(aggr.heap))
+			for _, v := range aggr.heap {
+				enh.out = append(enh.out, Sample{
+					Metric: v.Metric,
+					Point:  Point{V: v.V},
+				})
+			}
+			continue // Bypass default append.
+
+		case parser.BOTTOMK:
+			// The heap keeps the lowest value on top, so reverse it.
+			sort.Sort(sort.Reverse(aggr.reverseHeap))
+			for _, v := range aggr.reverseHeap {
+				enh.out = append(enh.out, Sample{
+					Metric: v.Metric,
+					Point:  Point{V: v.V},
+				})
+			}
+			continue // Bypass default append.
+
+		case parser.QUANTILE:
+			aggr.value = quantile(q, aggr.heap)
+
+		default:
+			// For other aggregations, we already have the right value.
+		}
+
+		enh.out = append(enh.out, Sample{
+			Metric: aggr.labels,
+			Point:  Point{V: aggr.value},
+		})
+	}
+	return enh.out
+}
+
+// minSeriesPerAggregationShard is the minimum number of input series a
+// worker must be given before groupSamplesSharded bothers splitting an
+// aggregation across goroutines; below it the overhead of spawning workers
+// and merging their partial results would outweigh the benefit.
+const minSeriesPerAggregationShard = 1000
+
+// groupSamples runs the grouping pass of aggregation over samples, producing
+// the partial per-group aggregates for just that slice of the input Vector.
+// vecLen is the length of the full input Vector (not len(samples)); it is
+// used only to size heap allocations, so a caller sharding the input across
+// several goroutines still passes the same vecLen to each call.
+func (ev *evaluator) groupSamples(op parser.ItemType, grouping []string, without bool, valueLabel string, k int64, kHash map[uint64]int64, vecLen int, samples Vector) map[uint64]*groupedAggregation {
+	result := make(map[uint64]*groupedAggregation, len(samples))
+
 	lb := labels.NewBuilder(nil)
 	buf := make([]byte, 0, 1024)
-	for _, s := range vec {
+	for _, s := range samples {
 		metric := s.Metric
 
 		if op == parser.COUNT_VALUES {
@@ -1945,6 +2406,16 @@ func (ev *evaluator) aggregation(op parser.ItemType, grouping []string, without
 			groupingKey, buf = metric.HashForLabels(buf, grouping...)
 		}
 
+		groupK := k
+		if kHash != nil {
+			if gk, ok := kHash[groupingKey]; ok {
+				groupK = gk
+			}
+			if groupK < 1 {
+				continue
+			}
+		}
+
 		group, ok := result[groupingKey]
 		// Add a new group if it doesn't exist.
 		if !ok {
@@ -1973,10 +2444,9 @@ func (ev *evaluator) aggregation(op parser.ItemType, grouping []string, without
 				mean:       s.V,
 				groupCount: 1,
 			}
-			inputVecLen := int64(len(vec))
-			resultSize := k
-			if k > inputVecLen {
-				resultSize = inputVecLen
+			resultSize := groupK
+			if groupK > int64(vecLen) {
+				resultSize = int64(vecLen)
 			}
 			if op == parser.STDVAR || op == parser.STDDEV {
 				result[groupingKey].value = 0.0
@@ -2024,8 +2494,8 @@ func (ev *evaluator) aggregation(op parser.ItemType, grouping []string, without
 			group.value += delta * (s.V - group.mean)
 
 		case parser.TOPK:
-			if int64(len(group.heap)) < k || group.heap[0].V < s.V || math.IsNaN(group.heap[0].V) {
-				if int64(len(group.heap)) == k {
+			if int64(len(group.heap)) < groupK || group.heap[0].V < s.V || math.IsNaN(group.heap[0].V) {
+				if int64(len(group.heap)) == groupK {
 					heap.Pop(&group.heap)
 				}
 				heap.Push(&group.heap, &Sample{
@@ -2035,8 +2505,8 @@ func (ev *evaluator) aggregation(op parser.ItemType, grouping []string, without
 			}
 
 		case parser.BOTTOMK:
-			if int64(len(group.reverseHeap)) < k || group.reverseHeap[0].V > s.V || math.IsNaN(group.reverseHeap[0].V) {
-				if int64(len(group.reverseHeap)) == k {
+			if int64(len(group.reverseHeap)) < groupK || group.reverseHeap[0].V > s.V || math.IsNaN(group.reverseHeap[0].V) {
+				if int64(len(group.reverseHeap)) == groupK {
 					heap.Pop(&group.reverseHeap)
 				}
 				heap.Push(&group.reverseHeap, &Sample{
@@ -2052,57 +2522,157 @@ func (ev *evaluator) aggregation(op parser.ItemType, grouping []string, without
 			panic(errors.Errorf("expected aggregation operator but got %q", op))
 		}
 	}
+	return result
+}
 
-	// Construct the result Vector from the aggregated groups.
-	for _, aggr := range result {
-		switch op {
-		case parser.AVG:
-			aggr.value = aggr.mean
+// groupSamplesSharded partitions vec into shards contiguous slices, groups
+// each of them concurrently via groupSamples, and merges the resulting
+// partial aggregates into a single map. It is equivalent to, but faster
+// than, a single groupSamples(..., vec) call when vec is large.
+func (ev *evaluator) groupSamplesSharded(op parser.ItemType, grouping []string, without bool, valueLabel string, k int64, kHash map[uint64]int64, vec Vector, shards int) map[uint64]*groupedAggregation {
+	chunkSize := (len(vec) + shards - 1) / shards
+	partials := make([]map[uint64]*groupedAggregation, (len(vec)+chunkSize-1)/chunkSize)
 
-		case parser.COUNT, parser.COUNT_VALUES:
-			aggr.value = float64(aggr.groupCount)
+	var wg sync.WaitGroup
+	for i := range partials {
+		start := i * chunkSize
+		end := start + chunkSize
+		if end > len(vec) {
+			end = len(vec)
+		}
+		wg.Add(1)
+		go func(i, start, end int) {
+			defer wg.Done()
+			partials[i] = ev.groupSamples(op, grouping, without, valueLabel, k, kHash, len(vec), vec[start:end])
+		}(i, start, end)
+	}
+	wg.Wait()
 
-		case parser.STDVAR:
-			aggr.value = aggr.value / float64(aggr.groupCount)
+	result := partials[0]
+	for _, partial := range partials[1:] {
+		mergeGroupedAggregations(op, k, kHash, result, partial)
+	}
+	return result
+}
 
-		case parser.STDDEV:
-			aggr.value = math.Sqrt(aggr.value / float64(aggr.groupCount))
+// mergeGroupedAggregations folds the partial per-group aggregates in src into
+// dst, combining the aggregates of any group present in both.
+func mergeGroupedAggregations(op parser.ItemType, k int64, kHash map[uint64]int64, dst, src map[uint64]*groupedAggregation) {
+	for groupingKey, s := range src {
+		d, ok := dst[groupingKey]
+		if !ok {
+			dst[groupingKey] = s
+			continue
+		}
 
-		case parser.TOPK:
-			// The heap keeps the lowest value on top, so reverse it.
-			sort.Sort(sort.Reverse(aggr.heap))
-			for _, v := range aggr.heap {
-				enh.out = append(enh.out, Sample{
-					Metric: v.Metric,
-					Point:  Point{V: v.V},
-				})
+		groupK := k
+		if kHash != nil {
+			if gk, ok := kHash[groupingKey]; ok {
+				groupK = gk
 			}
-			continue // Bypass default append.
+		}
+		mergeGroupedAggregation(op, groupK, d, s)
+	}
+}
 
-		case parser.BOTTOMK:
-			// The heap keeps the lowest value on top, so reverse it.
-			sort.Sort(sort.Reverse(aggr.reverseHeap))
-			for _, v := range aggr.reverseHeap {
-				enh.out = append(enh.out, Sample{
-					Metric: v.Metric,
-					Point:  Point{V: v.V},
-				})
+// mergeGroupedAggregation merges the partial aggregate src into dst, both of
+// which cover the same group but were built from disjoint sets of series.
+func mergeGroupedAggregation(op parser.ItemType, groupK int64, dst, src *groupedAggregation) {
+	switch op {
+	case parser.SUM:
+		dst.value += src.value
+
+	case parser.AVG:
+		total := float64(dst.groupCount + src.groupCount)
+		dst.mean = (dst.mean*float64(dst.groupCount) + src.mean*float64(src.groupCount)) / total
+		dst.groupCount += src.groupCount
+
+	case parser.MAX:
+		if dst.value < src.value || math.IsNaN(dst.value) {
+			dst.value = src.value
+		}
+
+	case parser.MIN:
+		if dst.value > src.value || math.IsNaN(dst.value) {
+			dst.value = src.value
+		}
+
+	case parser.COUNT, parser.COUNT_VALUES:
+		dst.groupCount += src.groupCount
+
+	case parser.STDVAR, parser.STDDEV:
+		// Parallel variance merge (Chan et al.): combine two (mean, M2,
+		// count) triples into one without revisiting either side's samples.
+		n, m := float64(dst.groupCount), float64(src.groupCount)
+		delta := src.mean - dst.mean
+		dst.value = dst.value + src.value + delta*delta*n*m/(n+m)
+		dst.mean = (dst.mean*n + src.mean*m) / (n + m)
+		dst.groupCount += src.groupCount
+
+	case parser.TOPK:
+		for _, v := range src.heap {
+			if int64(len(dst.heap)) < groupK || dst.heap[0].V < v.V || math.IsNaN(dst.heap[0].V) {
+				if int64(len(dst.heap)) == groupK {
+					heap.Pop(&dst.heap)
+				}
+				heap.Push(&dst.heap, &v)
 			}
-			continue // Bypass default append.
+		}
 
-		case parser.QUANTILE:
-			aggr.value = quantile(q, aggr.heap)
+	case parser.BOTTOMK:
+		for _, v := range src.reverseHeap {
+			if int64(len(dst.reverseHeap)) < groupK || dst.reverseHeap[0].V > v.V || math.IsNaN(dst.reverseHeap[0].V) {
+				if int64(len(dst.reverseHeap)) == groupK {
+					heap.Pop(&dst.reverseHeap)
+				}
+				heap.Push(&dst.reverseHeap, &v)
+			}
+		}
 
-		default:
-			// For other aggregations, we already have the right value.
+	case parser.QUANTILE:
+		dst.heap = append(dst.heap, src.heap...)
+
+	default:
+		panic(errors.Errorf("expected aggregation operator but got %q", op))
+	}
+}
+
+// resolveGroupingPatterns expands the `label_matching("<regex>")` entries of
+// a grouping clause (see parser.AggregateExpr.GroupingPatterns) into the
+// names of the labels actually present in vec that match one of the regexps,
+// unioned with the literal label names in base.
+func resolveGroupingPatterns(base []string, patterns []string, vec Vector) []string {
+	matchers := make([]*labels.FastRegexMatcher, 0, len(patterns))
+	for _, p := range patterns {
+		m, err := labels.NewFastRegexMatcher(p)
+		if err != nil {
+			// preprocessGroupingPatterns already rejected invalid regexps
+			// at parse time, so this can't happen.
+			continue
 		}
+		matchers = append(matchers, m)
+	}
 
-		enh.out = append(enh.out, Sample{
-			Metric: aggr.labels,
-			Point:  Point{V: aggr.value},
-		})
+	seen := make(map[string]struct{}, len(base))
+	grouping := append([]string{}, base...)
+	for _, n := range grouping {
+		seen[n] = struct{}{}
 	}
-	return enh.out
+	for _, s := range vec {
+		for _, l := range s.Metric {
+			if _, ok := seen[l.Name]; ok || l.Name == labels.MetricName {
+				continue
+			}
+			for _, m := range matchers {
+				if m.MatchString(l.Name) {
+					seen[l.Name] = struct{}{}
+					grouping = append(grouping, l.Name)
+					break
+				}
+			}
+		}
+	}
+	return grouping
 }
 
 // btos returns 1 if b is true, 0 otherwise.
@@ -16,9 +16,13 @@ package promql
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
 	"sort"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -830,6 +834,225 @@ load 10s
 	}
 }
 
+func TestMaxQuerySampleBytes(t *testing.T) {
+	test, err := NewTest(t, `
+load 10s
+  metric 1 2
+`)
+	testutil.Ok(t, err)
+	defer test.Close()
+
+	err = test.Run()
+	testutil.Ok(t, err)
+
+	metricBytes := int64(labels.FromStrings("__name__", "metric").ByteSize())
+
+	cases := []struct {
+		Query          string
+		MaxSampleBytes int64
+		ExpectErr      bool
+		Start          time.Time
+	}{
+		{
+			// A budget of 0 disables the check.
+			Query:          "metric",
+			MaxSampleBytes: 0,
+			ExpectErr:      false,
+			Start:          time.Unix(1, 0),
+		},
+		{
+			Query:          "metric",
+			MaxSampleBytes: metricBytes + pointBytes,
+			ExpectErr:      false,
+			Start:          time.Unix(1, 0),
+		},
+		{
+			Query:          "metric",
+			MaxSampleBytes: metricBytes + pointBytes - 1,
+			ExpectErr:      true,
+			Start:          time.Unix(1, 0),
+		},
+	}
+
+	engine := test.QueryEngine()
+	for _, c := range cases {
+		engine.maxSampleBytes = c.MaxSampleBytes
+
+		qry, err := engine.NewInstantQuery(test.Queryable(), c.Query, c.Start)
+		testutil.Ok(t, err)
+
+		res := qry.Exec(test.Context())
+		if c.ExpectErr {
+			testutil.Equals(t, ErrTooManySampleBytes(env), res.Err)
+		} else {
+			testutil.Ok(t, res.Err)
+		}
+	}
+}
+
+// TestMaxQuerySampleBytesRange checks that a range query aborts as soon as
+// the per-step samples exceed the byte budget, rather than materializing the
+// whole result matrix first.
+func TestMaxQuerySampleBytesRange(t *testing.T) {
+	test, err := NewTest(t, `
+load 10s
+  metric 1 2 3 4 5 6 7 8 9 10
+`)
+	testutil.Ok(t, err)
+	defer test.Close()
+
+	err = test.Run()
+	testutil.Ok(t, err)
+
+	metricBytes := int64(labels.FromStrings("__name__", "metric").ByteSize())
+
+	cases := []struct {
+		Query          string
+		MaxSampleBytes int64
+		ExpectErr      bool
+	}{
+		{
+			// A budget of 0 disables the check.
+			Query:          "metric",
+			MaxSampleBytes: 0,
+			ExpectErr:      false,
+		},
+		{
+			// Enough budget for every step of the range.
+			Query:          "metric",
+			MaxSampleBytes: metricBytes + 10*pointBytes,
+			ExpectErr:      false,
+		},
+		{
+			// Not enough budget to reach the second step, let alone the end
+			// of the range.
+			Query:          "metric",
+			MaxSampleBytes: metricBytes + pointBytes,
+			ExpectErr:      true,
+		},
+	}
+
+	engine := test.QueryEngine()
+	for _, c := range cases {
+		engine.maxSampleBytes = c.MaxSampleBytes
+
+		qry, err := engine.NewRangeQuery(test.Queryable(), c.Query, time.Unix(0, 0), time.Unix(90, 0), 10*time.Second)
+		testutil.Ok(t, err)
+
+		res := qry.Exec(test.Context())
+		if c.ExpectErr {
+			testutil.Equals(t, ErrTooManySampleBytes(env), res.Err)
+		} else {
+			testutil.Ok(t, res.Err)
+		}
+	}
+}
+
+// TestMaxQuerySampleBytesRangeFunction checks that rangeEval's per-step byte
+// accounting -- used by functions, aggregations, and binops, unlike the bare
+// selector path already covered by TestMaxQuerySampleBytesRange -- charges a
+// series' label-set bytes once, not once per step, so it does not
+// spuriously abort well under the real budget.
+func TestMaxQuerySampleBytesRangeFunction(t *testing.T) {
+	const steps = 10
+	test, err := NewTest(t, `
+load 10s
+  metric 1 2 3 4 5 6 7 8 9 10
+`)
+	testutil.Ok(t, err)
+	defer test.Close()
+
+	err = test.Run()
+	testutil.Ok(t, err)
+
+	// The "metric" argument is fully evaluated up front into its own Matrix,
+	// charged once via the vector-selector path.
+	selectorBytes := int64(labels.FromStrings("__name__", "metric").ByteSize()) + steps*pointBytes
+	// abs() drops the __name__ label via dropMetricName, which goes through
+	// labels.Builder; its output keeps the builder's cap(len(base)) backing
+	// array even though the result has no labels left, so its ByteSize is
+	// not the same as labels.EmptyLabels(). Correctly charged once, not
+	// once per step.
+	outputLabels := labels.NewBuilder(labels.FromStrings("__name__", "metric")).Del(labels.MetricName).Labels()
+	outputBytes := int64(outputLabels.ByteSize()) + steps*pointBytes
+	trueTotal := selectorBytes + outputBytes
+
+	cases := []struct {
+		MaxSampleBytes int64
+		ExpectErr      bool
+	}{
+		{
+			// A budget of 0 disables the check.
+			MaxSampleBytes: 0,
+			ExpectErr:      false,
+		},
+		{
+			// Exactly enough budget for the real, once-per-series cost.
+			// Charging the output series' (empty) label set on every one
+			// of the 10 steps, rather than once, would overshoot this.
+			MaxSampleBytes: trueTotal,
+			ExpectErr:      false,
+		},
+		{
+			// One byte short of the real cost.
+			MaxSampleBytes: trueTotal - 1,
+			ExpectErr:      true,
+		},
+	}
+
+	engine := test.QueryEngine()
+	for _, c := range cases {
+		engine.maxSampleBytes = c.MaxSampleBytes
+
+		qry, err := engine.NewRangeQuery(test.Queryable(), "abs(metric)", time.Unix(0, 0), time.Unix(90, 0), 10*time.Second)
+		testutil.Ok(t, err)
+
+		res := qry.Exec(test.Context())
+		if c.ExpectErr {
+			testutil.Equals(t, ErrTooManySampleBytes(env), res.Err)
+		} else {
+			testutil.Ok(t, res.Err)
+		}
+	}
+}
+
+func TestQueryAnalysis(t *testing.T) {
+	test, err := NewTest(t, `
+load 10s
+  metric{job="a"} 1 2
+  metric{job="b"} 3 4
+`)
+	testutil.Ok(t, err)
+	defer test.Close()
+
+	err = test.Run()
+	testutil.Ok(t, err)
+
+	engine := test.QueryEngine()
+
+	qry, err := engine.NewInstantQuery(test.Queryable(), "sum(metric)", time.Unix(1, 0))
+	testutil.Ok(t, err)
+
+	// Without NewAnalyzeContext, no plan tree is recorded.
+	res := qry.Exec(test.Context())
+	testutil.Ok(t, res.Err)
+	testutil.Assert(t, qry.Analyze() == nil, "expected no analysis without NewAnalyzeContext")
+
+	qry, err = engine.NewInstantQuery(test.Queryable(), "sum(metric)", time.Unix(1, 0))
+	testutil.Ok(t, err)
+
+	res = qry.Exec(NewAnalyzeContext(test.Context()))
+	testutil.Ok(t, res.Err)
+
+	root := qry.Analyze()
+	testutil.Assert(t, root != nil, "expected an analysis tree with NewAnalyzeContext")
+	testutil.Equals(t, "sum", root.Name)
+	testutil.Equals(t, 1, root.Series)
+	testutil.Assert(t, len(root.Children) == 1, "expected sum() to have one child")
+	testutil.Equals(t, "vector selector", root.Children[0].Name)
+	testutil.Equals(t, 2, root.Children[0].Series)
+}
+
 func TestRecoverEvaluatorRuntime(t *testing.T) {
 	ev := &evaluator{logger: log.NewNopLogger()}
 
@@ -1141,6 +1364,201 @@ func TestSubquerySelector(t *testing.T) {
 	}
 }
 
+func TestRangeSelectorTooSmallForScrapeIntervalWarning(t *testing.T) {
+	test, err := NewTest(t, `load 10s
+							metric 1 2 3 4 5 6 7 8`)
+	testutil.Ok(t, err)
+	defer test.Close()
+	testutil.Ok(t, test.Run())
+
+	engine := test.QueryEngine()
+
+	// 10s scrape interval but a 10s range; a classic rate(x[1m])-with-1m-scrapes
+	// style misconfiguration should warn.
+	qry, err := engine.NewInstantQuery(test.Queryable(), "rate(metric[10s])", time.Unix(70, 0))
+	testutil.Ok(t, err)
+	res := qry.Exec(test.Context())
+	testutil.Ok(t, res.Err)
+	testutil.Assert(t, len(res.Warnings) > 0, "expected a warning for a range selector narrower than twice the scrape interval")
+
+	// A range comfortably larger than twice the scrape interval should not warn.
+	qry, err = engine.NewInstantQuery(test.Queryable(), "rate(metric[1m])", time.Unix(70, 0))
+	testutil.Ok(t, err)
+	res = qry.Exec(test.Context())
+	testutil.Ok(t, res.Err)
+	testutil.Equals(t, 0, len(res.Warnings))
+}
+
+func TestLabelMatchingGrouping(t *testing.T) {
+	parser.EnableLabelMatchingGrouping = true
+	defer func() { parser.EnableLabelMatchingGrouping = false }()
+
+	test, err := NewTest(t, `load 10s
+							http_requests{pod="a", app_foo="1", app_bar="2", instance="x"} 1
+							http_requests{pod="a", app_foo="3", app_bar="4", instance="y"} 2
+							http_requests{pod="b", app_foo="1", app_bar="2", instance="x"} 3`)
+	testutil.Ok(t, err)
+	defer test.Close()
+	testutil.Ok(t, test.Run())
+
+	engine := test.QueryEngine()
+	qry, err := engine.NewInstantQuery(test.Queryable(), `sum by (pod, label_matching("app_.*")) (http_requests)`, time.Unix(0, 0))
+	testutil.Ok(t, err)
+	res := qry.Exec(test.Context())
+	testutil.Ok(t, res.Err)
+
+	vec, err := res.Vector()
+	testutil.Ok(t, err)
+	testutil.Equals(t, 3, len(vec), "expected one group per distinct (pod, app_foo, app_bar) combination")
+	for _, s := range vec {
+		testutil.Assert(t, s.Metric.Has("pod"), "expected pod label to be kept, got %s", s.Metric)
+		testutil.Assert(t, s.Metric.Has("app_foo"), "expected app_foo label to be matched by label_matching(\"app_.*\"), got %s", s.Metric)
+		testutil.Assert(t, s.Metric.Has("app_bar"), "expected app_bar label to be matched by label_matching(\"app_.*\"), got %s", s.Metric)
+		testutil.Assert(t, !s.Metric.Has("instance"), "expected instance label to be dropped, got %s", s.Metric)
+	}
+}
+
+func TestNegativeOffset(t *testing.T) {
+	parser.EnableNegativeOffset = true
+	defer func() { parser.EnableNegativeOffset = false }()
+
+	test, err := NewTest(t, `load 10s
+							http_requests{instance="x"} 0 1 2 3 4 5 6 7 8 9`)
+	testutil.Ok(t, err)
+	defer test.Close()
+	testutil.Ok(t, test.Run())
+
+	engine := test.QueryEngine()
+	qry, err := engine.NewInstantQuery(test.Queryable(), `http_requests offset -30s`, time.Unix(30, 0))
+	testutil.Ok(t, err)
+	res := qry.Exec(test.Context())
+	testutil.Ok(t, res.Err)
+
+	vec, err := res.Vector()
+	testutil.Ok(t, err)
+	testutil.Equals(t, 1, len(vec))
+	// offset -30s at t=30s reads the same sample as t=60s, i.e. index 6.
+	testutil.Equals(t, 6.0, vec[0].V)
+}
+
+// TestAggregationSharding checks that groupSamplesSharded, which evaluates
+// an aggregation's grouping pass across several goroutines and merges their
+// partial results, produces the same per-group aggregates as the sequential
+// groupSamples for every aggregation operator.
+func TestAggregationSharding(t *testing.T) {
+	const numSeries = 5000
+
+	vec := make(Vector, 0, numSeries)
+	for i := 0; i < numSeries; i++ {
+		vec = append(vec, Sample{
+			Metric: labels.FromStrings(labels.MetricName, "m", "group", strconv.Itoa(i%7), "i", strconv.Itoa(i)),
+			Point:  Point{V: float64(i % 101)},
+		})
+	}
+
+	ev := &evaluator{}
+	grouping := []string{"group"}
+
+	for _, op := range []parser.ItemType{
+		parser.SUM, parser.AVG, parser.MAX, parser.MIN, parser.COUNT,
+		parser.STDVAR, parser.STDDEV, parser.TOPK, parser.BOTTOMK, parser.QUANTILE,
+	} {
+		var k int64
+		var q float64
+		switch op {
+		case parser.TOPK, parser.BOTTOMK:
+			k = 3
+		case parser.QUANTILE:
+			q = 0.9
+		}
+
+		sequential := ev.groupSamples(op, grouping, false, "", k, nil, len(vec), vec)
+		sharded := ev.groupSamplesSharded(op, grouping, false, "", k, nil, vec, 4)
+		testutil.Equals(t, len(sequential), len(sharded), "op %v: group count mismatch", op)
+
+		for groupingKey, want := range sequential {
+			got, ok := sharded[groupingKey]
+			testutil.Assert(t, ok, "op %v: missing group %d in sharded result", op, groupingKey)
+
+			switch op {
+			case parser.TOPK, parser.BOTTOMK:
+				testutil.Equals(t, len(want.heap)+len(want.reverseHeap), len(got.heap)+len(got.reverseHeap), "op %v: heap size mismatch", op)
+				sort.Sort(sort.Reverse(want.heap))
+				sort.Sort(sort.Reverse(got.heap))
+				sort.Sort(sort.Reverse(want.reverseHeap))
+				sort.Sort(sort.Reverse(got.reverseHeap))
+				for i := range want.heap {
+					testutil.Equals(t, want.heap[i].V, got.heap[i].V, "op %v: topk value mismatch", op)
+				}
+				for i := range want.reverseHeap {
+					testutil.Equals(t, want.reverseHeap[i].V, got.reverseHeap[i].V, "op %v: bottomk value mismatch", op)
+				}
+			case parser.QUANTILE:
+				testutil.Equals(t, quantile(q, want.heap), quantile(q, got.heap), "op %v: quantile mismatch", op)
+			case parser.AVG:
+				testutil.Assert(t, math.Abs(want.mean-got.mean) < 1e-9, "op %v: avg mismatch: want %v got %v", op, want.mean, got.mean)
+			case parser.STDVAR, parser.STDDEV:
+				wantVal, gotVal := want.value, got.value
+				if op == parser.STDVAR {
+					wantVal /= float64(want.groupCount)
+					gotVal /= float64(got.groupCount)
+				} else {
+					wantVal = math.Sqrt(wantVal / float64(want.groupCount))
+					gotVal = math.Sqrt(gotVal / float64(got.groupCount))
+				}
+				testutil.Assert(t, math.Abs(wantVal-gotVal) < 1e-6, "op %v: variance mismatch: want %v got %v", op, wantVal, gotVal)
+			default:
+				testutil.Equals(t, want.value, got.value, "op %v: value mismatch for group %d", op, groupingKey)
+			}
+		}
+	}
+}
+
+// TestAggregationConcurrencyEndToEnd checks that a real sum-by query through
+// the query engine returns the same result whether or not
+// Engine.aggregationConcurrency shards the aggregation across goroutines.
+func TestAggregationConcurrencyEndToEnd(t *testing.T) {
+	const (
+		numSeries = 4096
+		numGroups = 8
+	)
+	var input strings.Builder
+	input.WriteString("load 10s\n")
+	for i := 0; i < numSeries; i++ {
+		fmt.Fprintf(&input, "  metric{group=\"%d\", i=\"%d\"} %d\n", i%numGroups, i, i)
+	}
+
+	test, err := NewTest(t, input.String())
+	testutil.Ok(t, err)
+	defer test.Close()
+	testutil.Ok(t, test.Run())
+
+	engine := test.QueryEngine()
+	engine.maxSamplesPerQuery = numSeries * 10
+
+	runQuery := func(concurrency int) Vector {
+		engine.aggregationConcurrency = concurrency
+		qry, err := engine.NewInstantQuery(test.Queryable(), "sum by (group) (metric)", time.Unix(0, 0))
+		testutil.Ok(t, err)
+		res := qry.Exec(test.Context())
+		testutil.Ok(t, res.Err)
+		vec, err := res.Vector()
+		testutil.Ok(t, err)
+		sort.Slice(vec, func(i, j int) bool { return vec[i].Metric.Get("group") < vec[j].Metric.Get("group") })
+		return vec
+	}
+
+	sequential := runQuery(0)
+	sharded := runQuery(4)
+
+	testutil.Equals(t, numGroups, len(sequential))
+	testutil.Equals(t, len(sequential), len(sharded))
+	for i := range sequential {
+		testutil.Equals(t, sequential[i].Metric, sharded[i].Metric)
+		testutil.Equals(t, sequential[i].V, sharded[i].V)
+	}
+}
+
 type FakeQueryLogger struct {
 	closed bool
 	logs   []interface{}
@@ -208,20 +208,12 @@ func calcTrendValue(i int, tf, s0, s1, b float64) float64 {
 	return x + y
 }
 
-// Holt-Winters is similar to a weighted moving average, where historical data has exponentially less influence on the current data.
-// Holt-Winter also accounts for trends in data. The smoothing factor (0 < sf < 1) affects how historical data will affect the current
+// Double exponential smoothing is similar to a weighted moving average, where historical data has exponentially less influence on the current data.
+// It also accounts for trends in data. The smoothing factor (0 < sf < 1) affects how historical data will affect the current
 // data. A lower smoothing factor increases the influence of historical data. The trend factor (0 < tf < 1) affects
 // how trends in historical data will affect the current data. A higher trend factor increases the influence.
 // of trends. Algorithm taken from https://en.wikipedia.org/wiki/Exponential_smoothing titled: "Double exponential smoothing".
-func funcHoltWinters(vals []parser.Value, args parser.Expressions, enh *EvalNodeHelper) Vector {
-	samples := vals[0].(Matrix)[0]
-
-	// The smoothing factor argument.
-	sf := vals[1].(Vector)[0].V
-
-	// The trend factor argument.
-	tf := vals[2].(Vector)[0].V
-
+func doubleExponentialSmoothing(samples Matrix, sf, tf float64, enh *EvalNodeHelper) Vector {
 	// Sanity check the input.
 	if sf <= 0 || sf >= 1 {
 		panic(errors.Errorf("invalid smoothing factor. Expected: 0 < sf < 1, got: %f", sf))
@@ -230,7 +222,7 @@ func funcHoltWinters(vals []parser.Value, args parser.Expressions, enh *EvalNode
 		panic(errors.Errorf("invalid trend factor. Expected: 0 < tf < 1, got: %f", tf))
 	}
 
-	l := len(samples.Points)
+	l := len(samples[0].Points)
 
 	// Can't do the smoothing operation with less than two points.
 	if l < 2 {
@@ -239,15 +231,15 @@ func funcHoltWinters(vals []parser.Value, args parser.Expressions, enh *EvalNode
 
 	var s0, s1, b float64
 	// Set initial values.
-	s1 = samples.Points[0].V
-	b = samples.Points[1].V - samples.Points[0].V
+	s1 = samples[0].Points[0].V
+	b = samples[0].Points[1].V - samples[0].Points[0].V
 
 	// Run the smoothing operation.
 	var x, y float64
 	for i := 1; i < l; i++ {
 
 		// Scale the raw value against the smoothing factor.
-		x = sf * samples.Points[i].V
+		x = sf * samples[0].Points[i].V
 
 		// Scale the last smoothed value with the trend at this point.
 		b = calcTrendValue(i-1, tf, s0, s1, b)
@@ -261,6 +253,19 @@ func funcHoltWinters(vals []parser.Value, args parser.Expressions, enh *EvalNode
 	})
 }
 
+// === holt_winters(Matrix parser.ValueTypeMatrix, sf, tf parser.ValueTypeScalar) Vector ===
+// holt_winters is the older name for double_exponential_smoothing, kept for
+// backwards compatibility now that the latter has superseded it behind the
+// promql-experimental-functions feature flag.
+func funcHoltWinters(vals []parser.Value, args parser.Expressions, enh *EvalNodeHelper) Vector {
+	return doubleExponentialSmoothing(vals[0].(Matrix), vals[1].(Vector)[0].V, vals[2].(Vector)[0].V, enh)
+}
+
+// === double_exponential_smoothing(Matrix parser.ValueTypeMatrix, sf, tf parser.ValueTypeScalar) Vector ===
+func funcDoubleExponentialSmoothing(vals []parser.Value, args parser.Expressions, enh *EvalNodeHelper) Vector {
+	return doubleExponentialSmoothing(vals[0].(Matrix), vals[1].(Vector)[0].V, vals[2].(Vector)[0].V, enh)
+}
+
 // === sort(node parser.ValueTypeVector) Vector ===
 func funcSort(vals []parser.Value, args parser.Expressions, enh *EvalNodeHelper) Vector {
 	// NaN should sort to the bottom, so take descending sort with NaN first and
@@ -419,6 +424,28 @@ func funcQuantileOverTime(vals []parser.Value, args parser.Expressions, enh *Eva
 	})
 }
 
+// === mad_over_time(Matrix parser.ValueTypeMatrix) Vector ===
+// mad_over_time returns the median absolute deviation of the values in the
+// range vector, i.e. the median of the absolute deviations from the
+// median. Unlike stddev_over_time, a single outlier can only move the
+// result by as much as the typical deviation, making it a more robust
+// measure of spread for anomaly-detection rules.
+func funcMADOverTime(vals []parser.Value, args parser.Expressions, enh *EvalNodeHelper) Vector {
+	return aggrOverTime(vals, enh, func(values []Point) float64 {
+		samples := make(vectorByValueHeap, 0, len(values))
+		for _, v := range values {
+			samples = append(samples, Sample{Point: Point{V: v.V}})
+		}
+		median := quantile(0.5, samples)
+
+		deviations := make(vectorByValueHeap, 0, len(values))
+		for _, v := range values {
+			deviations = append(deviations, Sample{Point: Point{V: math.Abs(v.V - median)}})
+		}
+		return quantile(0.5, deviations)
+	})
+}
+
 // === stddev_over_time(Matrix parser.ValueTypeMatrix) Vector ===
 func funcStddevOverTime(vals []parser.Value, args parser.Expressions, enh *EvalNodeHelper) Vector {
 	return aggrOverTime(vals, enh, func(values []Point) float64 {
@@ -594,10 +621,12 @@ func funcPredictLinear(vals []parser.Value, args parser.Expressions, enh *EvalNo
 	})
 }
 
-// === histogram_quantile(k parser.ValueTypeScalar, Vector parser.ValueTypeVector) Vector ===
-func funcHistogramQuantile(vals []parser.Value, args parser.Expressions, enh *EvalNodeHelper) Vector {
-	q := vals[0].(Vector)[0].V
-	inVec := vals[1].(Vector)
+// groupBucketsByLe groups inVec's classic (le-bucketed) histogram series by
+// their underlying metric, stripping the __name__ and le labels. It is
+// shared by histogram_quantile, histogram_count and histogram_fraction,
+// which all need the same per-histogram grouping before aggregating the
+// buckets of each one differently.
+func groupBucketsByLe(inVec Vector, enh *EvalNodeHelper) map[string]*metricWithBuckets {
 	sigf := signatureFunc(false, enh.lblBuf, excludedLabels...)
 
 	if enh.signatureToMetricWithBuckets == nil {
@@ -629,12 +658,89 @@ func funcHistogramQuantile(vals []parser.Value, args parser.Expressions, enh *Ev
 		}
 		mb.buckets = append(mb.buckets, bucket{upperBound, el.V})
 	}
+	return enh.signatureToMetricWithBuckets
+}
+
+// === histogram_quantile(k parser.ValueTypeScalar, Vector parser.ValueTypeVector, [method parser.ValueTypeString]) Vector ===
+// histogram_quantile estimates the q-th quantile of classic (le-bucketed)
+// histograms in v.
+//
+// This codebase has no native-histogram sample type to take a quantile over
+// directly (see histogram_count/histogram_fraction above), so there is no
+// "series directly" input mode here; v must still be a vector of classic
+// histogram buckets. The optional third argument selects how a rank is
+// turned into a value within the bucket it falls in: "linear" (the
+// long-standing default) linearly interpolates between the bucket's bounds,
+// while "lower-bound" always returns the bucket's lower bound without
+// interpolating, mirroring the conservative estimate native-histogram
+// exponential buckets would report instead of a within-bucket interpolation.
+func funcHistogramQuantile(vals []parser.Value, args parser.Expressions, enh *EvalNodeHelper) Vector {
+	q := vals[0].(Vector)[0].V
+	inVec := vals[1].(Vector)
+
+	method := interpolationLinear
+	if len(args) >= 3 {
+		switch m := args[2].(*parser.StringLiteral).Val; m {
+		case string(interpolationLinear), string(interpolationLowerBound):
+			method = quantileInterpolationMethod(m)
+		default:
+			panic(errors.Errorf("invalid interpolation method %q for histogram_quantile(), expected %q or %q", m, interpolationLinear, interpolationLowerBound))
+		}
+	}
+
+	for _, mb := range groupBucketsByLe(inVec, enh) {
+		if len(mb.buckets) > 0 {
+			enh.out = append(enh.out, Sample{
+				Metric: mb.metric,
+				Point:  Point{V: bucketQuantile(q, mb.buckets, method)},
+			})
+		}
+	}
 
-	for _, mb := range enh.signatureToMetricWithBuckets {
+	return enh.out
+}
+
+// === histogram_count(Vector parser.ValueTypeVector) Vector ===
+// histogram_count reports the total number of observations of each classic
+// (le-bucketed) histogram in v, read off its +Inf bucket.
+//
+// Unlike its native-histogram namesake, this can't read an exact sum or
+// standard deviation off of a classic histogram's buckets, since a classic
+// histogram's bucket boundaries don't carry that information; only the
+// count is derivable that way. So, unlike histogram_count here,
+// histogram_sum and histogram_stddev/histogram_stdvar are not provided:
+// the existing per-histogram _sum series remains the way to get the exact
+// sum of a classic histogram.
+func funcHistogramCount(vals []parser.Value, args parser.Expressions, enh *EvalNodeHelper) Vector {
+	inVec := vals[0].(Vector)
+
+	for _, mb := range groupBucketsByLe(inVec, enh) {
 		if len(mb.buckets) > 0 {
 			enh.out = append(enh.out, Sample{
 				Metric: mb.metric,
-				Point:  Point{V: bucketQuantile(q, mb.buckets)},
+				Point:  Point{V: bucketCount(mb.buckets)},
+			})
+		}
+	}
+
+	return enh.out
+}
+
+// === histogram_fraction(lower, upper parser.ValueTypeScalar, Vector parser.ValueTypeVector) Vector ===
+// histogram_fraction estimates the fraction of observations of each classic
+// histogram in v that fall within [lower, upper], using the same linear
+// interpolation within a bucket that histogram_quantile uses in the other
+// direction.
+func funcHistogramFraction(vals []parser.Value, args parser.Expressions, enh *EvalNodeHelper) Vector {
+	lower := vals[0].(Vector)[0].V
+	upper := vals[1].(Vector)[0].V
+	inVec := vals[2].(Vector)
+
+	for _, mb := range groupBucketsByLe(inVec, enh) {
+		if len(mb.buckets) > 0 {
+			enh.out = append(enh.out, Sample{
+				Metric: mb.metric,
+				Point:  Point{V: bucketFraction(lower, upper, mb.buckets)},
 			})
 		}
 	}
@@ -801,6 +907,184 @@ func funcLabelJoin(vals []parser.Value, args parser.Expressions, enh *EvalNodeHe
 	return enh.out
 }
 
+// === label_trim(vector parser.ValueTypeVector, label, cutset parser.ValueTypeString) Vector ===
+func funcLabelTrim(vals []parser.Value, args parser.Expressions, enh *EvalNodeHelper) Vector {
+	var (
+		vector = vals[0].(Vector)
+		label  = args[1].(*parser.StringLiteral).Val
+		cutset = args[2].(*parser.StringLiteral).Val
+	)
+
+	if enh.dmn == nil {
+		enh.dmn = make(map[uint64]labels.Labels, len(enh.out))
+	}
+
+	for _, el := range vector {
+		h := el.Metric.Hash()
+		var outMetric labels.Labels
+		if l, ok := enh.dmn[h]; ok {
+			outMetric = l
+		} else {
+			lb := labels.NewBuilder(el.Metric)
+			lb.Set(label, strings.Trim(el.Metric.Get(label), cutset))
+			outMetric = lb.Labels()
+			enh.dmn[h] = outMetric
+		}
+
+		enh.out = append(enh.out, Sample{
+			Metric: outMetric,
+			Point:  Point{V: el.Point.V},
+		})
+	}
+	return enh.out
+}
+
+// === label_substr(vector parser.ValueTypeVector, label parser.ValueTypeString, start, length parser.ValueTypeScalar) Vector ===
+func funcLabelSubstr(vals []parser.Value, args parser.Expressions, enh *EvalNodeHelper) Vector {
+	var (
+		vector = vals[0].(Vector)
+		label  = args[1].(*parser.StringLiteral).Val
+		start  = int(vals[2].(Vector)[0].Point.V)
+		length = int(vals[3].(Vector)[0].Point.V)
+	)
+
+	if enh.dmn == nil {
+		enh.dmn = make(map[uint64]labels.Labels, len(enh.out))
+	}
+
+	for _, el := range vector {
+		h := el.Metric.Hash()
+		var outMetric labels.Labels
+		if l, ok := enh.dmn[h]; ok {
+			outMetric = l
+		} else {
+			val := []rune(el.Metric.Get(label))
+			from := start
+			if from < 0 {
+				from += len(val)
+			}
+			if from < 0 {
+				from = 0
+			}
+			if from > len(val) {
+				from = len(val)
+			}
+			to := from + length
+			if length < 0 || to > len(val) {
+				to = len(val)
+			}
+
+			lb := labels.NewBuilder(el.Metric)
+			lb.Set(label, string(val[from:to]))
+			outMetric = lb.Labels()
+			enh.dmn[h] = outMetric
+		}
+
+		enh.out = append(enh.out, Sample{
+			Metric: outMetric,
+			Point:  Point{V: el.Point.V},
+		})
+	}
+	return enh.out
+}
+
+// === label_map(vector parser.ValueTypeVector, label, from1, to1, from2, to2, ... parser.ValueTypeString) Vector ===
+func funcLabelMap(vals []parser.Value, args parser.Expressions, enh *EvalNodeHelper) Vector {
+	var (
+		vector = vals[0].(Vector)
+		label  = args[1].(*parser.StringLiteral).Val
+	)
+
+	if (len(args)-2)%2 != 0 {
+		panic(errors.Errorf("label_map(): mismatched from/to value count for label %q", label))
+	}
+	mapping := make(map[string]string, (len(args)-2)/2)
+	for i := 2; i < len(args); i += 2 {
+		mapping[args[i].(*parser.StringLiteral).Val] = args[i+1].(*parser.StringLiteral).Val
+	}
+
+	if enh.dmn == nil {
+		enh.dmn = make(map[uint64]labels.Labels, len(enh.out))
+	}
+
+	for _, el := range vector {
+		h := el.Metric.Hash()
+		var outMetric labels.Labels
+		if l, ok := enh.dmn[h]; ok {
+			outMetric = l
+		} else {
+			outMetric = el.Metric
+			if to, ok := mapping[el.Metric.Get(label)]; ok {
+				lb := labels.NewBuilder(el.Metric)
+				lb.Set(label, to)
+				outMetric = lb.Labels()
+			}
+			enh.dmn[h] = outMetric
+		}
+
+		enh.out = append(enh.out, Sample{
+			Metric: outMetric,
+			Point:  Point{V: el.Point.V},
+		})
+	}
+	return enh.out
+}
+
+// limitRatioModulus is the range that a series' label hash is reduced into
+// before being compared against the requested ratio in funcLimitRatio.
+const limitRatioModulus = 1e6
+
+// === limitk(k parser.ValueTypeScalar, vector parser.ValueTypeVector) Vector ===
+// limitk returns up to k series from vector, chosen by each series' label
+// hash rather than by its value. Unlike topk/bottomk this makes the sample
+// stable from one evaluation to the next instead of reshuffling whenever
+// values cross each other, which is what callers exploring high-cardinality
+// data actually want from "just show me k representative series".
+func funcLimitK(vals []parser.Value, args parser.Expressions, enh *EvalNodeHelper) Vector {
+	vec := vals[1].(Vector)
+	k := int(vals[0].(Vector)[0].Point.V)
+	if k < 1 {
+		return enh.out
+	}
+	if k > len(vec) {
+		k = len(vec)
+	}
+	sort.Slice(vec, func(i, j int) bool {
+		return vec[i].Metric.Hash() < vec[j].Metric.Hash()
+	})
+	return append(enh.out, vec[:k]...)
+}
+
+// === limit_ratio(r parser.ValueTypeScalar, vector parser.ValueTypeVector) Vector ===
+// limit_ratio keeps roughly a fraction r, between -1 and 1, of vector's
+// series, chosen deterministically by label hash in the same style as the
+// remote-write sample-ratio keep decision. A negative r keeps the
+// complementary fraction, so two limit_ratio calls with r and -r against the
+// same vector partition it into two non-overlapping samples.
+func funcLimitRatio(vals []parser.Value, args parser.Expressions, enh *EvalNodeHelper) Vector {
+	vec := vals[1].(Vector)
+	r := vals[0].(Vector)[0].Point.V
+	switch {
+	case r == 0:
+		return enh.out
+	case r > 1:
+		r = 1
+	case r < -1:
+		r = -1
+	}
+	for _, el := range vec {
+		frac := float64(el.Metric.Hash()%limitRatioModulus) / limitRatioModulus
+		if r >= 0 {
+			if frac < r {
+				enh.out = append(enh.out, el)
+			}
+		} else if frac >= 1+r {
+			enh.out = append(enh.out, el)
+		}
+	}
+	return enh.out
+}
+
 // Common code for date related functions.
 func dateWrapper(vals []parser.Value, enh *EvalNodeHelper, f func(time.Time) float64) Vector {
 	if len(vals) == 0 {
@@ -872,53 +1156,62 @@ func funcYear(vals []parser.Value, args parser.Expressions, enh *EvalNodeHelper)
 
 // FunctionCalls is a list of all functions supported by PromQL, including their types.
 var FunctionCalls = map[string]FunctionCall{
-	"abs":                funcAbs,
-	"absent":             funcAbsent,
-	"absent_over_time":   funcAbsentOverTime,
-	"avg_over_time":      funcAvgOverTime,
-	"ceil":               funcCeil,
-	"changes":            funcChanges,
-	"clamp_max":          funcClampMax,
-	"clamp_min":          funcClampMin,
-	"count_over_time":    funcCountOverTime,
-	"days_in_month":      funcDaysInMonth,
-	"day_of_month":       funcDayOfMonth,
-	"day_of_week":        funcDayOfWeek,
-	"delta":              funcDelta,
-	"deriv":              funcDeriv,
-	"exp":                funcExp,
-	"floor":              funcFloor,
-	"histogram_quantile": funcHistogramQuantile,
-	"holt_winters":       funcHoltWinters,
-	"hour":               funcHour,
-	"idelta":             funcIdelta,
-	"increase":           funcIncrease,
-	"irate":              funcIrate,
-	"label_replace":      funcLabelReplace,
-	"label_join":         funcLabelJoin,
-	"ln":                 funcLn,
-	"log10":              funcLog10,
-	"log2":               funcLog2,
-	"max_over_time":      funcMaxOverTime,
-	"min_over_time":      funcMinOverTime,
-	"minute":             funcMinute,
-	"month":              funcMonth,
-	"predict_linear":     funcPredictLinear,
-	"quantile_over_time": funcQuantileOverTime,
-	"rate":               funcRate,
-	"resets":             funcResets,
-	"round":              funcRound,
-	"scalar":             funcScalar,
-	"sort":               funcSort,
-	"sort_desc":          funcSortDesc,
-	"sqrt":               funcSqrt,
-	"stddev_over_time":   funcStddevOverTime,
-	"stdvar_over_time":   funcStdvarOverTime,
-	"sum_over_time":      funcSumOverTime,
-	"time":               funcTime,
-	"timestamp":          funcTimestamp,
-	"vector":             funcVector,
-	"year":               funcYear,
+	"abs":                          funcAbs,
+	"absent":                       funcAbsent,
+	"absent_over_time":             funcAbsentOverTime,
+	"avg_over_time":                funcAvgOverTime,
+	"ceil":                         funcCeil,
+	"changes":                      funcChanges,
+	"clamp_max":                    funcClampMax,
+	"clamp_min":                    funcClampMin,
+	"count_over_time":              funcCountOverTime,
+	"days_in_month":                funcDaysInMonth,
+	"day_of_month":                 funcDayOfMonth,
+	"day_of_week":                  funcDayOfWeek,
+	"delta":                        funcDelta,
+	"deriv":                        funcDeriv,
+	"double_exponential_smoothing": funcDoubleExponentialSmoothing,
+	"exp":                          funcExp,
+	"floor":                        funcFloor,
+	"histogram_count":              funcHistogramCount,
+	"histogram_fraction":           funcHistogramFraction,
+	"histogram_quantile":           funcHistogramQuantile,
+	"holt_winters":                 funcHoltWinters,
+	"hour":                         funcHour,
+	"idelta":                       funcIdelta,
+	"increase":                     funcIncrease,
+	"irate":                        funcIrate,
+	"label_replace":                funcLabelReplace,
+	"label_join":                   funcLabelJoin,
+	"label_trim":                   funcLabelTrim,
+	"label_substr":                 funcLabelSubstr,
+	"label_map":                    funcLabelMap,
+	"limitk":                       funcLimitK,
+	"limit_ratio":                  funcLimitRatio,
+	"ln":                           funcLn,
+	"log10":                        funcLog10,
+	"log2":                         funcLog2,
+	"mad_over_time":                funcMADOverTime,
+	"max_over_time":                funcMaxOverTime,
+	"min_over_time":                funcMinOverTime,
+	"minute":                       funcMinute,
+	"month":                        funcMonth,
+	"predict_linear":               funcPredictLinear,
+	"quantile_over_time":           funcQuantileOverTime,
+	"rate":                         funcRate,
+	"resets":                       funcResets,
+	"round":                        funcRound,
+	"scalar":                       funcScalar,
+	"sort":                         funcSort,
+	"sort_desc":                    funcSortDesc,
+	"sqrt":                         funcSqrt,
+	"stddev_over_time":             funcStddevOverTime,
+	"stdvar_over_time":             funcStdvarOverTime,
+	"sum_over_time":                funcSumOverTime,
+	"time":                         funcTime,
+	"timestamp":                    funcTimestamp,
+	"vector":                       funcVector,
+	"year":                         funcYear,
 }
 
 type vectorByValueHeap Vector
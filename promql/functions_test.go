@@ -59,6 +59,192 @@ func TestDeriv(t *testing.T) {
 	testutil.Assert(t, vec[0].V == 0.0, "Expected 0.0 as value, got %f", vec[0].V)
 }
 
+func TestExperimentalLabelFunctions(t *testing.T) {
+	parser.EnableExperimentalFunctions = true
+	defer func() { parser.EnableExperimentalFunctions = false }()
+
+	storage := teststorage.New(t)
+	defer storage.Close()
+	opts := EngineOpts{
+		Logger:     nil,
+		Reg:        nil,
+		MaxSamples: 10000,
+		Timeout:    10 * time.Second,
+	}
+	engine := NewEngine(opts)
+
+	a := storage.Appender()
+	_, err := a.Add(labels.FromStrings("__name__", "testmetric", "env", "  Production  "), 0, 1.0)
+	testutil.Ok(t, err)
+	testutil.Ok(t, a.Commit())
+
+	cases := []struct {
+		query    string
+		expected string
+	}{
+		{`label_trim(testmetric, "env", " ")`, "Production"},
+		{`label_substr(testmetric, "env", 2, 4)`, "Prod"},
+		{`label_map(testmetric, "env", "  Production  ", "prod")`, "prod"},
+	}
+	for _, c := range cases {
+		query, err := engine.NewInstantQuery(storage, c.query, timestamp.Time(0))
+		testutil.Ok(t, err)
+		result := query.Exec(context.Background())
+		testutil.Ok(t, result.Err)
+
+		vec, err := result.Vector()
+		testutil.Ok(t, err)
+		testutil.Assert(t, len(vec) == 1, "Expected 1 result for %q, got %d", c.query, len(vec))
+		testutil.Equals(t, c.expected, vec[0].Metric.Get("env"))
+	}
+}
+
+func TestExperimentalFunctionsRequireFeatureFlag(t *testing.T) {
+	_, err := parser.ParseExpr(`label_trim(testmetric, "env", " ")`)
+	testutil.NotOk(t, err, "expected parsing label_trim to fail without the feature flag enabled")
+}
+
+func TestLimitKAndLimitRatio(t *testing.T) {
+	parser.EnableExperimentalFunctions = true
+	defer func() { parser.EnableExperimentalFunctions = false }()
+
+	storage := teststorage.New(t)
+	defer storage.Close()
+	opts := EngineOpts{
+		Logger:     nil,
+		Reg:        nil,
+		MaxSamples: 10000,
+		Timeout:    10 * time.Second,
+	}
+	engine := NewEngine(opts)
+
+	a := storage.Appender()
+	for i := 0; i < 10; i++ {
+		_, err := a.Add(labels.FromStrings("__name__", "testmetric", "instance", fmt.Sprintf("%d", i)), 0, 1.0)
+		testutil.Ok(t, err)
+	}
+	testutil.Ok(t, a.Commit())
+
+	instant := timestamp.Time(0)
+	runQuery := func(q string) Vector {
+		query, err := engine.NewInstantQuery(storage, q, instant)
+		testutil.Ok(t, err)
+		result := query.Exec(context.Background())
+		testutil.Ok(t, result.Err)
+		vec, err := result.Vector()
+		testutil.Ok(t, err)
+		return vec
+	}
+
+	first := runQuery(`limitk(3, testmetric)`)
+	testutil.Assert(t, len(first) == 3, "expected limitk(3, ...) to return 3 series, got %d", len(first))
+	second := runQuery(`limitk(3, testmetric)`)
+	testutil.Equals(t, first, second)
+
+	kept := runQuery(`limit_ratio(0.3, testmetric)`)
+	complement := runQuery(`limit_ratio(-0.7, testmetric)`)
+	seen := map[uint64]bool{}
+	for _, s := range kept {
+		seen[s.Metric.Hash()] = true
+	}
+	for _, s := range complement {
+		testutil.Assert(t, !seen[s.Metric.Hash()], "limit_ratio(0.3, ...) and limit_ratio(-0.7, ...) overlapped on %s", s.Metric)
+	}
+}
+
+func TestHistogramCountAndFraction(t *testing.T) {
+	parser.EnableExperimentalFunctions = true
+	defer func() { parser.EnableExperimentalFunctions = false }()
+
+	storage := teststorage.New(t)
+	defer storage.Close()
+	opts := EngineOpts{
+		Logger:     nil,
+		Reg:        nil,
+		MaxSamples: 10000,
+		Timeout:    10 * time.Second,
+	}
+	engine := NewEngine(opts)
+
+	a := storage.Appender()
+	buckets := []struct {
+		le    string
+		value float64
+	}{
+		{"0.1", 2},
+		{"0.5", 7},
+		{"1", 9},
+		{"+Inf", 10},
+	}
+	for _, b := range buckets {
+		_, err := a.Add(labels.FromStrings("__name__", "testhistogram_bucket", "le", b.le), 0, b.value)
+		testutil.Ok(t, err)
+	}
+	testutil.Ok(t, a.Commit())
+
+	runQuery := func(q string) Vector {
+		query, err := engine.NewInstantQuery(storage, q, timestamp.Time(0))
+		testutil.Ok(t, err)
+		result := query.Exec(context.Background())
+		testutil.Ok(t, result.Err)
+		vec, err := result.Vector()
+		testutil.Ok(t, err)
+		return vec
+	}
+
+	count := runQuery(`histogram_count(testhistogram_bucket)`)
+	testutil.Assert(t, len(count) == 1, "expected 1 result, got %d", len(count))
+	testutil.Equals(t, 10.0, count[0].V)
+
+	fraction := runQuery(`histogram_fraction(0, 0.1, testhistogram_bucket)`)
+	testutil.Assert(t, len(fraction) == 1, "expected 1 result, got %d", len(fraction))
+	testutil.Equals(t, 0.2, fraction[0].V)
+
+	all := runQuery(`histogram_fraction(0, +Inf, testhistogram_bucket)`)
+	testutil.Equals(t, 1.0, all[0].V)
+}
+
+func TestDoubleExponentialSmoothing(t *testing.T) {
+	_, err := parser.ParseExpr(`double_exponential_smoothing(testmetric[1m], 0.01, 0.1)`)
+	testutil.NotOk(t, err, "expected parsing double_exponential_smoothing to fail without the feature flag enabled")
+
+	parser.EnableExperimentalFunctions = true
+	defer func() { parser.EnableExperimentalFunctions = false }()
+
+	storage := teststorage.New(t)
+	defer storage.Close()
+	opts := EngineOpts{
+		Logger:     nil,
+		Reg:        nil,
+		MaxSamples: 10000,
+		Timeout:    10 * time.Second,
+	}
+	engine := NewEngine(opts)
+
+	a := storage.Appender()
+	for i := int64(0); i < 1000; i++ {
+		_, err := a.Add(labels.FromStrings("__name__", "testmetric"), i*10_000, float64(i*10))
+		testutil.Ok(t, err)
+	}
+	testutil.Ok(t, a.Commit())
+
+	runQuery := func(q string) Vector {
+		query, err := engine.NewInstantQuery(storage, q, timestamp.Time(8_000_000))
+		testutil.Ok(t, err)
+		result := query.Exec(context.Background())
+		testutil.Ok(t, result.Err)
+		vec, err := result.Vector()
+		testutil.Ok(t, err)
+		return vec
+	}
+
+	// double_exponential_smoothing is a straight rename of holt_winters, so
+	// both must agree on the same input.
+	des := runQuery(`double_exponential_smoothing(testmetric[1m], 0.01, 0.1)`)
+	hw := runQuery(`holt_winters(testmetric[1m], 0.01, 0.1)`)
+	testutil.Equals(t, hw, des)
+}
+
 func TestFunctionList(t *testing.T) {
 	// Test that Functions and parser.Functions list the same functions.
 	for i := range FunctionCalls {
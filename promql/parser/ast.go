@@ -90,6 +90,13 @@ type AggregateExpr struct {
 	Grouping []string // The labels by which to group the Vector.
 	Without  bool     // Whether to drop the given labels rather than keep them.
 	PosRange PositionRange
+
+	// GroupingPatterns holds the regular expressions behind any
+	// `label_matching("<regex>")` entries in the grouping clause (see
+	// --enable-feature=promql-label-matching-grouping). At evaluation time
+	// they are resolved against the labels actually present on the input
+	// Vector and the matching label names are added to Grouping.
+	GroupingPatterns []string
 }
 
 // BinaryExpr represents a binary expression between two child expressions.
@@ -168,6 +175,15 @@ type VectorSelector struct {
 	Offset        time.Duration
 	LabelMatchers []*labels.Matcher
 
+	// Timestamp, if set, pins the selector to a fixed evaluation timestamp
+	// (in milliseconds since the Unix epoch) via the `@ <unix timestamp>`
+	// modifier, instead of the query's own evaluation timestamp.
+	Timestamp *int64
+	// StartOrEnd is set instead of Timestamp for the `@ start()` and
+	// `@ end()` forms of the modifier, which pin the selector to the start
+	// or end of the query's evaluation range. It is NoStartOrEnd otherwise.
+	StartOrEnd StartOrEnd
+
 	// The unexpanded seriesSet populated at query preparation time.
 	UnexpandedSeriesSet storage.SeriesSet
 	Series              []storage.Series
@@ -175,6 +191,19 @@ type VectorSelector struct {
 	PosRange PositionRange
 }
 
+// StartOrEnd identifies which of the query's evaluation range boundaries a
+// `@ start()` / `@ end()` modifier refers to.
+type StartOrEnd int
+
+const (
+	// NoStartOrEnd means no `@ start()`/`@ end()` modifier was used.
+	NoStartOrEnd StartOrEnd = iota
+	// StartModifier corresponds to `@ start()`.
+	StartModifier
+	// EndModifier corresponds to `@ end()`.
+	EndModifier
+)
+
 // TestStmt is an internal helper statement that allows execution
 // of an arbitrary function during handling. It is used to test the Engine.
 type TestStmt func(context.Context) error
@@ -0,0 +1,216 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// atClause is a single `@ <timestamp>` / `@ start()` / `@ end()` modifier
+// found by preprocessAtModifiers, along with the position it was removed
+// from in the (post-removal) query string it was found in.
+type atClause struct {
+	pos        Pos
+	timestamp  *int64
+	startOrEnd StartOrEnd
+}
+
+// preprocessAtModifiers strips `@` modifiers out of the query string and
+// returns them alongside the position each was removed from, for
+// applyAtModifiers to re-attach to the selector they followed once the
+// (now `@`-free) string has been parsed by the generated grammar.
+//
+// This is a textual, pre-lexing fold for the same reason
+// preprocessDurationExpressions is: the `@` modifier isn't part of the
+// grammar the goyacc-generated parser understands (see generated_parser.y),
+// and teaching it to do so would require regenerating that parser.
+//
+// carry holds positions recorded by an earlier fold (preprocessNegativeOffsets)
+// against input; like preprocessDurationExpressions, it translates carry
+// into the coordinate space of the returned string and hands it back
+// alongside it. See advanceCarry.
+func preprocessAtModifiers(input string, carry []Pos) (string, []atClause, []Pos, error) {
+	var (
+		b          strings.Builder
+		clauses    []atClause
+		quote      rune
+		braceDepth int
+		resolved   = make([]Pos, len(carry))
+		carryIdx   int
+	)
+
+	for i := 0; i < len(input); {
+		carryIdx = advanceCarry(carry, resolved, carryIdx, i, b.Len())
+		c := input[i]
+
+		if quote != 0 {
+			b.WriteByte(c)
+			if c == '\\' && i+1 < len(input) {
+				b.WriteByte(input[i+1])
+				i += 2
+				continue
+			}
+			if rune(c) == quote {
+				quote = 0
+			}
+			i++
+			continue
+		}
+
+		switch {
+		case c == '"' || c == '\'' || c == '`':
+			quote = rune(c)
+			b.WriteByte(c)
+			i++
+		case c == '{':
+			braceDepth++
+			b.WriteByte(c)
+			i++
+		case c == '}':
+			braceDepth--
+			b.WriteByte(c)
+			i++
+		case c == '@' && braceDepth == 0:
+			clause, end, err := parseAtClause(input, i)
+			if err != nil {
+				return "", nil, nil, err
+			}
+			clause.pos = Pos(b.Len())
+			clauses = append(clauses, clause)
+			i = end
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	advanceCarry(carry, resolved, carryIdx, len(input), b.Len())
+
+	return b.String(), clauses, resolved, nil
+}
+
+// parseAtClause parses the `@ <timestamp>` / `@ start()` / `@ end()`
+// modifier starting at input[at] (input[at] == '@'), and returns the parsed
+// clause together with the index of the first byte after it.
+func parseAtClause(input string, at int) (atClause, int, error) {
+	i := at + 1
+	for i < len(input) && isSpace(rune(input[i])) {
+		i++
+	}
+
+	if rest := input[i:]; strings.HasPrefix(rest, "start") || strings.HasPrefix(rest, "end") {
+		kw := "start"
+		soe := StartModifier
+		if strings.HasPrefix(rest, "end") {
+			kw = "end"
+			soe = EndModifier
+		}
+		j := i + len(kw)
+		for j < len(input) && isSpace(rune(input[j])) {
+			j++
+		}
+		if j < len(input) && input[j] == '(' {
+			j++
+			for j < len(input) && isSpace(rune(input[j])) {
+				j++
+			}
+			if j < len(input) && input[j] == ')' {
+				return atClause{startOrEnd: soe}, j + 1, nil
+			}
+		}
+	}
+
+	j := i
+	if j < len(input) && (input[j] == '+' || input[j] == '-') {
+		j++
+	}
+	digitsStart := j
+	for j < len(input) && input[j] >= '0' && input[j] <= '9' {
+		j++
+	}
+	if j < len(input) && input[j] == '.' {
+		j++
+		for j < len(input) && input[j] >= '0' && input[j] <= '9' {
+			j++
+		}
+	}
+	if j == digitsStart {
+		return atClause{}, 0, errors.Errorf("unexpected character %q after @ modifier, expected a unix timestamp, start() or end()", input[i])
+	}
+
+	seconds, err := strconv.ParseFloat(input[i:j], 64)
+	if err != nil {
+		return atClause{}, 0, errors.Wrap(err, "invalid timestamp in @ modifier")
+	}
+	ts := int64(math.Round(seconds * 1000))
+	return atClause{timestamp: &ts}, j, nil
+}
+
+// applyAtModifiers attaches the timestamp clauses found by
+// preprocessAtModifiers to the VectorSelector each one immediately follows
+// in final (the string that was actually parsed, i.e. after both the
+// duration-expression and @-modifier folds). A clause immediately follows a
+// selector if, ignoring whitespace, nothing else appears between the
+// selector's end and the clause -- e.g. the clause in `foo[5m] @ 100` is
+// attached to foo's range selector, but the one in `sum(foo) @ 100` is
+// rejected, since `@` only applies directly to instant and range vector
+// selectors.
+func applyAtModifiers(expr Expr, final string, clauses []atClause) error {
+	type candidate struct {
+		end Pos
+		vs  *VectorSelector
+	}
+	var candidates []candidate
+
+	Inspect(expr, func(node Node, _ []Node) error {
+		switch n := node.(type) {
+		case *VectorSelector:
+			candidates = append(candidates, candidate{end: n.PosRange.End, vs: n})
+		case *MatrixSelector:
+			if vs, ok := n.VectorSelector.(*VectorSelector); ok {
+				candidates = append(candidates, candidate{end: n.EndPos, vs: vs})
+			}
+		}
+		return nil
+	})
+
+	for _, cl := range clauses {
+		var best *candidate
+		for i := range candidates {
+			c := &candidates[i]
+			if c.end > cl.pos {
+				continue
+			}
+			if best != nil && c.end <= best.end {
+				continue
+			}
+			if strings.TrimFunc(final[c.end:cl.pos], isSpace) != "" {
+				continue
+			}
+			best = c
+		}
+		if best == nil {
+			return errors.New("@ modifier must be preceded by an instant vector selector or range vector selector")
+		}
+		if best.vs.Timestamp != nil || best.vs.StartOrEnd != NoStartOrEnd {
+			return errors.New("@ may not be set multiple times")
+		}
+		best.vs.Timestamp = cl.timestamp
+		best.vs.StartOrEnd = cl.startOrEnd
+	}
+	return nil
+}
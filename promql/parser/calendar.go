@@ -0,0 +1,70 @@
+// Copyright 2021 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"time"
+)
+
+// CalendarUnit identifies a calendar-aligned duration unit, as opposed to
+// the fixed-length units (s, m, h, d, w, y) that Duration already supports.
+// A calendar day or week is not a fixed number of seconds in every
+// timezone -- DST transitions can make it 23 or 25 hours -- so aligning to
+// one requires wall-clock arithmetic in a specific *time.Location rather
+// than adding a time.Duration.
+type CalendarUnit byte
+
+const (
+	// CalendarDay aligns to local midnight.
+	CalendarDay CalendarUnit = 'd'
+	// CalendarWeek aligns to the most recent Monday midnight.
+	CalendarWeek CalendarUnit = 'w'
+)
+
+// ParseCalendarUnit parses the single-character calendar unit used by the
+// "c"-prefixed duration syntax (e.g. the "d" in "1cd", the "w" in "1cw").
+// It returns an error for anything other than CalendarDay or CalendarWeek;
+// calendar months and years are not supported since their length in
+// seconds varies too much for range-vector math to stay meaningful.
+func ParseCalendarUnit(s string) (CalendarUnit, error) {
+	if len(s) == 1 {
+		switch CalendarUnit(s[0]) {
+		case CalendarDay, CalendarWeek:
+			return CalendarUnit(s[0]), nil
+		}
+	}
+	return 0, fmt.Errorf("not a valid calendar unit: %q", s)
+}
+
+// AlignToCalendarUnit rounds t down to the start of its enclosing calendar
+// day or week in loc, the primitive that evaluating a calendar-aligned
+// offset or range (e.g. "offset 1cw") needs: unlike t.Truncate, which
+// operates on t's absolute UTC instant, this walks the wall-clock fields of
+// t as observed in loc, so the result lands on local midnight even across a
+// DST transition.
+func AlignToCalendarUnit(t time.Time, unit CalendarUnit, loc *time.Location) (time.Time, error) {
+	lt := t.In(loc)
+	day := time.Date(lt.Year(), lt.Month(), lt.Day(), 0, 0, 0, 0, loc)
+	switch unit {
+	case CalendarDay:
+		return day, nil
+	case CalendarWeek:
+		// time.Weekday is 0 for Sunday; ISO weeks start on Monday.
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset), nil
+	default:
+		return time.Time{}, fmt.Errorf("not a valid calendar unit: %q", string(rune(unit)))
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/util/testutil"
+)
+
+func TestParseCalendarUnit(t *testing.T) {
+	unit, err := ParseCalendarUnit("d")
+	testutil.Ok(t, err)
+	testutil.Equals(t, CalendarDay, unit)
+
+	unit, err = ParseCalendarUnit("w")
+	testutil.Ok(t, err)
+	testutil.Equals(t, CalendarWeek, unit)
+
+	_, err = ParseCalendarUnit("y")
+	testutil.NotOk(t, err, "calendar years are not supported")
+
+	_, err = ParseCalendarUnit("")
+	testutil.NotOk(t, err, "empty unit")
+}
+
+func TestAlignToCalendarUnit(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	testutil.Ok(t, err)
+
+	// A Wednesday afternoon, mid-DST.
+	in := time.Date(2021, time.July, 14, 15, 30, 0, 0, loc)
+
+	day, err := AlignToCalendarUnit(in, CalendarDay, loc)
+	testutil.Ok(t, err)
+	testutil.Equals(t, time.Date(2021, time.July, 14, 0, 0, 0, 0, loc), day)
+
+	week, err := AlignToCalendarUnit(in, CalendarWeek, loc)
+	testutil.Ok(t, err)
+	testutil.Equals(t, time.Date(2021, time.July, 12, 0, 0, 0, 0, loc), week)
+
+	// Aligning a Monday should be a no-op.
+	monday := time.Date(2021, time.July, 12, 9, 0, 0, 0, loc)
+	week, err = AlignToCalendarUnit(monday, CalendarWeek, loc)
+	testutil.Ok(t, err)
+	testutil.Equals(t, time.Date(2021, time.July, 12, 0, 0, 0, 0, loc), week)
+
+	_, err = AlignToCalendarUnit(in, CalendarUnit('y'), loc)
+	testutil.NotOk(t, err, "calendar years are not supported")
+}
@@ -0,0 +1,467 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/common/model"
+)
+
+// preprocessDurationExpressions rewrites the small set of duration
+// arithmetic expressions this parser supports -- `[<expr>]` range selectors
+// and `offset (<expr>)` modifiers -- into the single DURATION token the
+// generated grammar expects, e.g. `[2 * 1h]` becomes `[2h]` and
+// `offset (1d + 2h)` becomes `offset 26h`.
+//
+// This is a textual, pre-lexing fold rather than real grammar support: the
+// grammar's "duration" production only accepts a single DURATION token
+// (see generated_parser.y), and teaching it to accept arbitrary
+// sub-expressions would require regenerating the goyacc-based parser.
+// Anything that isn't a closed-form arithmetic expression over duration and
+// number literals -- most notably dashboard templating variables such as
+// `$__interval`, which Prometheus itself has no notion of -- is left
+// untouched and will go on to fail in the normal parser exactly as before.
+//
+// carry holds positions recorded by an earlier fold (preprocessNegativeOffsets)
+// against input; it is translated into the coordinate space of the
+// returned string and handed back alongside it, so that fold's clauses stay
+// attached to the right place even though this fold can change the string's
+// length before them. See advanceCarry.
+func preprocessDurationExpressions(input string, carry []Pos) (string, []Pos, error) {
+	var (
+		b          strings.Builder
+		quote      rune
+		braceDepth int
+		resolved   = make([]Pos, len(carry))
+		carryIdx   int
+	)
+
+	for i := 0; i < len(input); {
+		carryIdx = advanceCarry(carry, resolved, carryIdx, i, b.Len())
+		c := input[i]
+
+		if quote != 0 {
+			b.WriteByte(c)
+			if c == '\\' && i+1 < len(input) {
+				b.WriteByte(input[i+1])
+				i += 2
+				continue
+			}
+			if rune(c) == quote {
+				quote = 0
+			}
+			i++
+			continue
+		}
+
+		switch {
+		case c == '"' || c == '\'' || c == '`':
+			quote = rune(c)
+			b.WriteByte(c)
+			i++
+		case c == '{':
+			braceDepth++
+			b.WriteByte(c)
+			i++
+		case c == '}':
+			braceDepth--
+			b.WriteByte(c)
+			i++
+		case c == '[' && braceDepth == 0:
+			end := matchingBracket(input, i, '[', ']')
+			if end < 0 {
+				b.WriteByte(c)
+				i++
+				continue
+			}
+			folded, err := foldBracketContent(input[i+1 : end])
+			if err != nil {
+				return "", nil, err
+			}
+			b.WriteByte('[')
+			b.WriteString(folded)
+			b.WriteByte(']')
+			i = end + 1
+		case braceDepth == 0 && isOffsetKeywordAt(input, i):
+			b.WriteString("offset")
+			i += len("offset")
+			j := i
+			for j < len(input) && isSpace(rune(input[j])) {
+				j++
+			}
+			if j >= len(input) || input[j] != '(' {
+				// A bare `offset <duration>` is already a single DURATION
+				// token; leave it for the normal grammar to handle.
+				b.WriteString(input[i:j])
+				i = j
+				continue
+			}
+			end := matchingBracket(input, j, '(', ')')
+			if end < 0 {
+				b.WriteString(input[i:j])
+				i = j
+				continue
+			}
+			dur, err := evalDurationExpr(input[j+1 : end])
+			if err != nil {
+				return "", nil, errors.Wrap(err, "evaluating offset expression")
+			}
+			b.WriteString(input[i:j])
+			b.WriteString(model.Duration(dur).String())
+			i = end + 1
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	advanceCarry(carry, resolved, carryIdx, len(input), b.Len())
+
+	return b.String(), resolved, nil
+}
+
+// advanceCarry resolves positions in carry -- an ascending list of byte
+// offsets into a fold's input, recorded by an earlier fold -- into the
+// coordinate space of the string the caller is in the middle of building,
+// up to and including input index i. Call it at the top of a fold's main
+// scan loop (and once more after the loop, with i set to len(input), to
+// resolve any carried positions at or past the end of the string), passing
+// the builder's length so far as outLen. It returns the advanced carryIdx.
+func advanceCarry(carry []Pos, resolved []Pos, carryIdx, i, outLen int) int {
+	for carryIdx < len(carry) && carry[carryIdx] <= Pos(i) {
+		resolved[carryIdx] = Pos(outLen)
+		carryIdx++
+	}
+	return carryIdx
+}
+
+// foldBracketContent folds the content of a `[...]` range selector. It only
+// touches the range part of a subquery (`[<range>:<step>]`); the step is
+// left untouched since the request this supports only asked for range
+// selectors and offsets.
+func foldBracketContent(content string) (string, error) {
+	rangePart, rest, hasColon := cutTopLevel(content, ':')
+	if !strings.ContainsAny(rangePart, "+-*/()") {
+		return content, nil
+	}
+	dur, err := evalDurationExpr(rangePart)
+	if err != nil {
+		return "", errors.Wrap(err, "evaluating range selector expression")
+	}
+	folded := model.Duration(dur).String()
+	if hasColon {
+		return folded + ":" + rest, nil
+	}
+	return folded, nil
+}
+
+// cutTopLevel splits s at the first top-level occurrence of sep (i.e. not
+// inside parentheses), mirroring strings.Cut.
+func cutTopLevel(s string, sep byte) (before, after string, found bool) {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case sep:
+			if depth == 0 {
+				return s[:i], s[i+1:], true
+			}
+		}
+	}
+	return s, "", false
+}
+
+// matchingBracket returns the index of the closing bracket matching the
+// opening bracket at input[open], or -1 if it's unbalanced. Balancing is
+// left to the real lexer in that case.
+func matchingBracket(input string, open int, openCh, closeCh byte) int {
+	depth := 0
+	var quote byte
+	for i := open; i < len(input); i++ {
+		c := input[i]
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '"', '\'', '`':
+			quote = c
+		case openCh:
+			depth++
+		case closeCh:
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func isOffsetKeywordAt(input string, i int) bool {
+	const kw = "offset"
+	if !strings.HasPrefix(input[i:], kw) {
+		return false
+	}
+	if i > 0 && isAlphaNumeric(rune(input[i-1])) {
+		return false
+	}
+	after := i + len(kw)
+	if after < len(input) && isAlphaNumeric(rune(input[after])) {
+		return false
+	}
+	return true
+}
+
+// durVal is an intermediate result of evaluating a duration expression. A
+// bare number (no unit) can scale a duration, but can't stand in for one.
+type durVal struct {
+	seconds float64
+	hasUnit bool
+}
+
+// evalDurationExpr evaluates an arithmetic expression over duration and
+// number literals, e.g. "2 * 1h" or "1d + 2h", and requires the result to be
+// a duration greater than zero.
+func evalDurationExpr(expr string) (time.Duration, error) {
+	d, err := evalSignedDurationExpr(expr)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, errors.New("duration must be greater than 0")
+	}
+	return d, nil
+}
+
+// evalSignedDurationExpr evaluates the same arithmetic expressions as
+// evalDurationExpr, but allows the result to be zero or negative. It backs
+// the negative offset support in negativeoffset.go, since `offset -5m`
+// makes sense (see preprocessNegativeOffsets) where a negative range
+// selector or `@` timestamp would not.
+func evalSignedDurationExpr(expr string) (time.Duration, error) {
+	toks, err := tokenizeDurationExpr(expr)
+	if err != nil {
+		return 0, err
+	}
+	if len(toks) == 0 {
+		return 0, errors.New("empty duration expression")
+	}
+	p := &durExprParser{toks: toks}
+	v, err := p.parseExpr()
+	if err != nil {
+		return 0, err
+	}
+	if p.pos != len(p.toks) {
+		return 0, errors.Errorf("unexpected %q in duration expression", p.toks[p.pos].text)
+	}
+	if !v.hasUnit {
+		return 0, errors.New("duration expression has no time unit")
+	}
+	return time.Duration(v.seconds * float64(time.Second)), nil
+}
+
+type durExprTokenKind int
+
+const (
+	durTokNumber durExprTokenKind = iota
+	durTokDuration
+	durTokOp
+	durTokLParen
+	durTokRParen
+)
+
+type durExprToken struct {
+	kind durExprTokenKind
+	text string
+}
+
+func tokenizeDurationExpr(expr string) ([]durExprToken, error) {
+	var toks []durExprToken
+	i := 0
+	for i < len(expr) {
+		c := expr[i]
+		switch {
+		case isSpace(rune(c)):
+			i++
+		case c == '+' || c == '-' || c == '*' || c == '/':
+			toks = append(toks, durExprToken{durTokOp, string(c)})
+			i++
+		case c == '(':
+			toks = append(toks, durExprToken{durTokLParen, "("})
+			i++
+		case c == ')':
+			toks = append(toks, durExprToken{durTokRParen, ")"})
+			i++
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(expr) && expr[j] >= '0' && expr[j] <= '9' {
+				j++
+			}
+			if j < len(expr) && expr[j] == '.' {
+				j++
+				for j < len(expr) && expr[j] >= '0' && expr[j] <= '9' {
+					j++
+				}
+			}
+			unitEnd := j
+			if unitEnd < len(expr) && expr[unitEnd] == 'm' && unitEnd+1 < len(expr) && expr[unitEnd+1] == 's' {
+				unitEnd += 2
+			} else if unitEnd < len(expr) && strings.ContainsRune("smhdwy", rune(expr[unitEnd])) {
+				unitEnd++
+			}
+			if unitEnd > j {
+				toks = append(toks, durExprToken{durTokDuration, expr[i:unitEnd]})
+			} else {
+				toks = append(toks, durExprToken{durTokNumber, expr[i:j]})
+			}
+			i = unitEnd
+			if unitEnd == j {
+				i = j
+			}
+		default:
+			return nil, errors.Errorf("unexpected character %q in duration expression", c)
+		}
+	}
+	return toks, nil
+}
+
+type durExprParser struct {
+	toks []durExprToken
+	pos  int
+}
+
+func (p *durExprParser) peek() (durExprToken, bool) {
+	if p.pos >= len(p.toks) {
+		return durExprToken{}, false
+	}
+	return p.toks[p.pos], true
+}
+
+func (p *durExprParser) parseExpr() (durVal, error) {
+	v, err := p.parseTerm()
+	if err != nil {
+		return durVal{}, err
+	}
+	for {
+		t, ok := p.peek()
+		if !ok || t.kind != durTokOp || (t.text != "+" && t.text != "-") {
+			return v, nil
+		}
+		p.pos++
+		rhs, err := p.parseTerm()
+		if err != nil {
+			return durVal{}, err
+		}
+		if !v.hasUnit || !rhs.hasUnit {
+			return durVal{}, errors.New("addition and subtraction require both operands to be durations")
+		}
+		if t.text == "+" {
+			v.seconds += rhs.seconds
+		} else {
+			v.seconds -= rhs.seconds
+		}
+	}
+}
+
+func (p *durExprParser) parseTerm() (durVal, error) {
+	v, err := p.parseFactor()
+	if err != nil {
+		return durVal{}, err
+	}
+	for {
+		t, ok := p.peek()
+		if !ok || t.kind != durTokOp || (t.text != "*" && t.text != "/") {
+			return v, nil
+		}
+		p.pos++
+		rhs, err := p.parseFactor()
+		if err != nil {
+			return durVal{}, err
+		}
+		if t.text == "*" {
+			if v.hasUnit && rhs.hasUnit {
+				return durVal{}, errors.New("cannot multiply two durations")
+			}
+			v = durVal{seconds: v.seconds * rhs.seconds, hasUnit: v.hasUnit || rhs.hasUnit}
+		} else {
+			if rhs.hasUnit {
+				return durVal{}, errors.New("cannot divide by a duration")
+			}
+			if rhs.seconds == 0 {
+				return durVal{}, errors.New("division by zero in duration expression")
+			}
+			v = durVal{seconds: v.seconds / rhs.seconds, hasUnit: v.hasUnit}
+		}
+	}
+}
+
+func (p *durExprParser) parseFactor() (durVal, error) {
+	t, ok := p.peek()
+	if !ok {
+		return durVal{}, errors.New("unexpected end of duration expression")
+	}
+	switch t.kind {
+	case durTokOp:
+		if t.text != "-" && t.text != "+" {
+			return durVal{}, errors.Errorf("unexpected %q in duration expression", t.text)
+		}
+		p.pos++
+		v, err := p.parseFactor()
+		if err != nil {
+			return durVal{}, err
+		}
+		if t.text == "-" {
+			v.seconds = -v.seconds
+		}
+		return v, nil
+	case durTokNumber:
+		p.pos++
+		n, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return durVal{}, err
+		}
+		return durVal{seconds: n}, nil
+	case durTokDuration:
+		p.pos++
+		d, err := model.ParseDuration(t.text)
+		if err != nil {
+			return durVal{}, err
+		}
+		return durVal{seconds: time.Duration(d).Seconds(), hasUnit: true}, nil
+	case durTokLParen:
+		p.pos++
+		v, err := p.parseExpr()
+		if err != nil {
+			return durVal{}, err
+		}
+		if t, ok := p.peek(); !ok || t.kind != durTokRParen {
+			return durVal{}, errors.New("missing closing parenthesis in duration expression")
+		}
+		p.pos++
+		return v, nil
+	default:
+		return durVal{}, errors.Errorf("unexpected %q in duration expression", t.text)
+	}
+}
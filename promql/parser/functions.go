@@ -13,6 +13,12 @@
 
 package parser
 
+// EnableExperimentalFunctions controls whether experimental PromQL functions,
+// currently label_trim, label_substr, label_map, limitk, limit_ratio,
+// histogram_count and histogram_fraction, are available to queries.
+// It is set from the --enable-feature=promql-experimental-functions flag.
+var EnableExperimentalFunctions = false
+
 // Function represents a function of the expression language and is
 // used by function nodes.
 type Function struct {
@@ -20,6 +26,8 @@ type Function struct {
 	ArgTypes   []ValueType
 	Variadic   int
 	ReturnType ValueType
+	// Experimental functions are only available if EnableExperimentalFunctions is true.
+	Experimental bool
 }
 
 // Functions is a list of all functions supported by PromQL, including their types.
@@ -97,6 +105,12 @@ var Functions = map[string]*Function{
 		ArgTypes:   []ValueType{ValueTypeMatrix},
 		ReturnType: ValueTypeVector,
 	},
+	"double_exponential_smoothing": {
+		Name:         "double_exponential_smoothing",
+		ArgTypes:     []ValueType{ValueTypeMatrix, ValueTypeScalar, ValueTypeScalar},
+		ReturnType:   ValueTypeVector,
+		Experimental: true,
+	},
 	"exp": {
 		Name:       "exp",
 		ArgTypes:   []ValueType{ValueTypeVector},
@@ -107,9 +121,22 @@ var Functions = map[string]*Function{
 		ArgTypes:   []ValueType{ValueTypeVector},
 		ReturnType: ValueTypeVector,
 	},
+	"histogram_count": {
+		Name:         "histogram_count",
+		ArgTypes:     []ValueType{ValueTypeVector},
+		ReturnType:   ValueTypeVector,
+		Experimental: true,
+	},
+	"histogram_fraction": {
+		Name:         "histogram_fraction",
+		ArgTypes:     []ValueType{ValueTypeScalar, ValueTypeScalar, ValueTypeVector},
+		ReturnType:   ValueTypeVector,
+		Experimental: true,
+	},
 	"histogram_quantile": {
 		Name:       "histogram_quantile",
-		ArgTypes:   []ValueType{ValueTypeScalar, ValueTypeVector},
+		ArgTypes:   []ValueType{ValueTypeScalar, ValueTypeVector, ValueTypeString},
+		Variadic:   1,
 		ReturnType: ValueTypeVector,
 	},
 	"holt_winters": {
@@ -149,6 +176,37 @@ var Functions = map[string]*Function{
 		Variadic:   -1,
 		ReturnType: ValueTypeVector,
 	},
+	"label_trim": {
+		Name:         "label_trim",
+		ArgTypes:     []ValueType{ValueTypeVector, ValueTypeString, ValueTypeString},
+		ReturnType:   ValueTypeVector,
+		Experimental: true,
+	},
+	"label_substr": {
+		Name:         "label_substr",
+		ArgTypes:     []ValueType{ValueTypeVector, ValueTypeString, ValueTypeScalar, ValueTypeScalar},
+		ReturnType:   ValueTypeVector,
+		Experimental: true,
+	},
+	"label_map": {
+		Name:         "label_map",
+		ArgTypes:     []ValueType{ValueTypeVector, ValueTypeString, ValueTypeString, ValueTypeString},
+		Variadic:     -1,
+		ReturnType:   ValueTypeVector,
+		Experimental: true,
+	},
+	"limitk": {
+		Name:         "limitk",
+		ArgTypes:     []ValueType{ValueTypeScalar, ValueTypeVector},
+		ReturnType:   ValueTypeVector,
+		Experimental: true,
+	},
+	"limit_ratio": {
+		Name:         "limit_ratio",
+		ArgTypes:     []ValueType{ValueTypeScalar, ValueTypeVector},
+		ReturnType:   ValueTypeVector,
+		Experimental: true,
+	},
 	"ln": {
 		Name:       "ln",
 		ArgTypes:   []ValueType{ValueTypeVector},
@@ -164,6 +222,11 @@ var Functions = map[string]*Function{
 		ArgTypes:   []ValueType{ValueTypeVector},
 		ReturnType: ValueTypeVector,
 	},
+	"mad_over_time": {
+		Name:       "mad_over_time",
+		ArgTypes:   []ValueType{ValueTypeMatrix},
+		ReturnType: ValueTypeVector,
+	},
 	"max_over_time": {
 		Name:       "max_over_time",
 		ArgTypes:   []ValueType{ValueTypeMatrix},
@@ -0,0 +1,182 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/util/strutil"
+)
+
+// EnableLabelMatchingGrouping controls whether `label_matching("<regex>")`
+// may be used in place of a label name in a `by`/`without` grouping clause,
+// e.g. `sum by (pod, label_matching("app_.*")) (metric)`. It is set from the
+// --enable-feature=promql-label-matching-grouping flag.
+var EnableLabelMatchingGrouping = false
+
+// labelMatchingPlaceholderPrefix prefixes the synthetic label names
+// substituted for each `label_matching(...)` occurrence by
+// preprocessGroupingPatterns, so applyGroupingPatterns can recognize them
+// afterwards without needing to track where in the query they came from.
+const labelMatchingPlaceholderPrefix = "__label_matching_placeholder_"
+
+// preprocessGroupingPatterns replaces each `label_matching("<regex>")` call
+// with a synthetic label name the generated grammar accepts in a
+// grouping_label_list, and returns the regex each placeholder stands for so
+// applyGroupingPatterns can swap them back in once the query has been
+// parsed.
+//
+// This is a textual, pre-lexing fold rather than real grammar support: the
+// grammar's grouping_label production only accepts a single label name (see
+// generated_parser.y), and teaching it to accept a function call would
+// require regenerating the goyacc-based parser.
+func preprocessGroupingPatterns(input string) (string, map[string]string, error) {
+	var (
+		b        strings.Builder
+		patterns map[string]string
+		quote    rune
+	)
+
+	for i := 0; i < len(input); {
+		c := input[i]
+
+		if quote != 0 {
+			b.WriteByte(c)
+			if c == '\\' && i+1 < len(input) {
+				b.WriteByte(input[i+1])
+				i += 2
+				continue
+			}
+			if rune(c) == quote {
+				quote = 0
+			}
+			i++
+			continue
+		}
+
+		if c == '"' || c == '\'' || c == '`' {
+			quote = rune(c)
+			b.WriteByte(c)
+			i++
+			continue
+		}
+
+		if strings.HasPrefix(input[i:], "label_matching") && !isIdentContinuation(input, i+len("label_matching")) {
+			pattern, end, ok, err := parseLabelMatchingCall(input, i+len("label_matching"))
+			if err != nil {
+				return "", nil, err
+			}
+			if ok {
+				if !EnableLabelMatchingGrouping {
+					return "", nil, errors.New("label_matching() in a grouping clause is experimental and must be enabled with --enable-feature=promql-label-matching-grouping")
+				}
+				if _, err := labels.NewFastRegexMatcher(pattern); err != nil {
+					return "", nil, errors.Wrap(err, "invalid regular expression in label_matching()")
+				}
+				if patterns == nil {
+					patterns = map[string]string{}
+				}
+				placeholder := fmt.Sprintf("%s%d", labelMatchingPlaceholderPrefix, len(patterns))
+				patterns[placeholder] = pattern
+				b.WriteString(placeholder)
+				i = end
+				continue
+			}
+		}
+
+		b.WriteByte(c)
+		i++
+	}
+
+	return b.String(), patterns, nil
+}
+
+// isIdentContinuation reports whether input[at] continues an identifier,
+// i.e. whether the identifier ending right before "at" is actually longer
+// than what was matched (e.g. "label_matching2" is not "label_matching").
+func isIdentContinuation(input string, at int) bool {
+	return at < len(input) && isAlphaNumeric(rune(input[at]))
+}
+
+// parseLabelMatchingCall parses the "(<string>)" following the
+// "label_matching" keyword at input[at:]. ok is false (with no error) if
+// input[at:] isn't a well-formed call at all, e.g. "label_matchingfoo" or a
+// plain "label_matching" used as an ordinary label name.
+func parseLabelMatchingCall(input string, at int) (pattern string, end int, ok bool, err error) {
+	i := at
+	for i < len(input) && isSpace(rune(input[i])) {
+		i++
+	}
+	if i >= len(input) || input[i] != '(' {
+		return "", 0, false, nil
+	}
+	i++
+	for i < len(input) && isSpace(rune(input[i])) {
+		i++
+	}
+	if i >= len(input) || (input[i] != '"' && input[i] != '\'' && input[i] != '`') {
+		return "", 0, false, errors.New("label_matching() expects a single quoted regular expression argument")
+	}
+	quote := input[i]
+	start := i
+	i++
+	for i < len(input) && input[i] != quote {
+		if input[i] == '\\' && quote != '`' && i+1 < len(input) {
+			i++
+		}
+		i++
+	}
+	if i >= len(input) {
+		return "", 0, false, errors.New("unterminated quoted string in label_matching() argument")
+	}
+	i++ // Consume the closing quote.
+	pattern, err = strutil.Unquote(input[start:i])
+	if err != nil {
+		return "", 0, false, errors.Wrap(err, "invalid label_matching() argument")
+	}
+	for i < len(input) && isSpace(rune(input[i])) {
+		i++
+	}
+	if i >= len(input) || input[i] != ')' {
+		return "", 0, false, errors.New("label_matching() takes exactly one quoted regular expression argument")
+	}
+	return pattern, i + 1, true, nil
+}
+
+// applyGroupingPatterns moves the synthetic placeholders substituted by
+// preprocessGroupingPatterns out of every AggregateExpr's Grouping and into
+// its GroupingPatterns, restoring the regular expression each one stands
+// for.
+func applyGroupingPatterns(expr Expr, patterns map[string]string) {
+	Inspect(expr, func(node Node, _ []Node) error {
+		agg, ok := node.(*AggregateExpr)
+		if !ok {
+			return nil
+		}
+		grouping := agg.Grouping[:0]
+		for _, g := range agg.Grouping {
+			if pattern, ok := patterns[g]; ok {
+				agg.GroupingPatterns = append(agg.GroupingPatterns, pattern)
+				continue
+			}
+			grouping = append(grouping, g)
+		}
+		agg.Grouping = grouping
+		return nil
+	})
+}
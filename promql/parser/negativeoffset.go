@@ -0,0 +1,253 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// EnableNegativeOffset controls whether `offset -5m` (and other zero or
+// negative offset expressions) are accepted, for rules that are evaluated
+// behind a deliberate delay but still want to reference data at or after
+// their own evaluation time, e.g. already-ingested data that arrived behind
+// wall clock via backfill or remote write. It is set from the
+// --enable-feature=promql-negative-offset flag.
+var EnableNegativeOffset = false
+
+// negOffsetClause is a single zero-or-negative `offset` modifier found by
+// preprocessNegativeOffsets, along with the position it was removed from in
+// the (post-removal) query string, for applyNegativeOffsets to re-attach to
+// the selector it followed once the query has been parsed.
+type negOffsetClause struct {
+	pos    Pos
+	offset time.Duration
+}
+
+// negOffsetClausePositions extracts clauses' positions, in order, for
+// preprocessDurationExpressions and preprocessAtModifiers to carry through
+// and translate as they fold input further.
+func negOffsetClausePositions(clauses []negOffsetClause) []Pos {
+	positions := make([]Pos, len(clauses))
+	for i, cl := range clauses {
+		positions[i] = cl.pos
+	}
+	return positions
+}
+
+// setNegOffsetClausePositions writes positions -- translated by the later
+// folds -- back into clauses, in place.
+func setNegOffsetClausePositions(clauses []negOffsetClause, positions []Pos) {
+	for i := range clauses {
+		clauses[i].pos = positions[i]
+	}
+}
+
+// preprocessNegativeOffsets strips zero-or-negative `offset` modifiers out
+// of the query string and returns them alongside the position each was
+// removed from. Positive offsets, including parenthesized expressions that
+// evaluate to a positive duration, are left untouched for
+// preprocessDurationExpressions and the generated grammar to handle exactly
+// as before.
+//
+// This is a textual, pre-lexing fold for the same reason
+// preprocessDurationExpressions and preprocessAtModifiers are: the
+// grammar's "duration" production only accepts a single non-negative
+// DURATION token (see generated_parser.y), and teaching it to accept a
+// negative one would require regenerating the goyacc-based parser.
+func preprocessNegativeOffsets(input string) (string, []negOffsetClause, error) {
+	var (
+		b          strings.Builder
+		clauses    []negOffsetClause
+		quote      rune
+		braceDepth int
+	)
+
+	for i := 0; i < len(input); {
+		c := input[i]
+
+		if quote != 0 {
+			b.WriteByte(c)
+			if c == '\\' && i+1 < len(input) {
+				b.WriteByte(input[i+1])
+				i += 2
+				continue
+			}
+			if rune(c) == quote {
+				quote = 0
+			}
+			i++
+			continue
+		}
+
+		switch {
+		case c == '"' || c == '\'' || c == '`':
+			quote = rune(c)
+			b.WriteByte(c)
+			i++
+		case c == '{':
+			braceDepth++
+			b.WriteByte(c)
+			i++
+		case c == '}':
+			braceDepth--
+			b.WriteByte(c)
+			i++
+		case braceDepth == 0 && isOffsetKeywordAt(input, i):
+			dur, end, found, err := parseNonPositiveOffsetClause(input, i)
+			if err != nil {
+				return "", nil, err
+			}
+			if !found {
+				b.WriteString("offset")
+				i += len("offset")
+				continue
+			}
+			if !EnableNegativeOffset {
+				return "", nil, errors.New("zero or negative offset is experimental and must be enabled with --enable-feature=promql-negative-offset")
+			}
+			clauses = append(clauses, negOffsetClause{pos: Pos(b.Len()), offset: dur})
+			i = end
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+
+	return b.String(), clauses, nil
+}
+
+// parseNonPositiveOffsetClause looks at the `offset ...` clause starting at
+// input[at:] (input[at:] starts with "offset") and, if it evaluates to a
+// duration that is zero or negative, returns it together with the index of
+// the first byte after the clause. found is false, with no error, for an
+// ordinary positive offset, which is left for preprocessDurationExpressions
+// and the grammar to handle.
+func parseNonPositiveOffsetClause(input string, at int) (dur time.Duration, end int, found bool, err error) {
+	i := at + len("offset")
+	for i < len(input) && isSpace(rune(input[i])) {
+		i++
+	}
+
+	if i < len(input) && input[i] == '(' {
+		closeIdx := matchingBracket(input, i, '(', ')')
+		if closeIdx < 0 {
+			return 0, 0, false, nil
+		}
+		d, err := evalSignedDurationExpr(input[i+1 : closeIdx])
+		if err != nil || d > 0 {
+			// Leave it for preprocessDurationExpressions (or, if it's
+			// malformed, the grammar) to produce the right error message.
+			return 0, 0, false, nil
+		}
+		return d, closeIdx + 1, true, nil
+	}
+
+	lit, litEnd, ok := scanSignedDurationLiteral(input, i)
+	if !ok {
+		return 0, 0, false, nil
+	}
+	d, err := evalSignedDurationExpr(lit)
+	if err != nil || d > 0 {
+		return 0, 0, false, nil
+	}
+	return d, litEnd, true, nil
+}
+
+// scanSignedDurationLiteral scans a single optionally-signed duration
+// literal (e.g. "-5m", "+1.5h"), mirroring the DURATION token the lexer
+// itself would scan for a bare (unsigned, unparenthesized) `offset`
+// modifier, but allowing a leading sign.
+func scanSignedDurationLiteral(input string, at int) (literal string, end int, ok bool) {
+	j := at
+	if j < len(input) && (input[j] == '+' || input[j] == '-') {
+		j++
+	}
+	digitsStart := j
+	for j < len(input) && input[j] >= '0' && input[j] <= '9' {
+		j++
+	}
+	if j < len(input) && input[j] == '.' {
+		j++
+		for j < len(input) && input[j] >= '0' && input[j] <= '9' {
+			j++
+		}
+	}
+	if j == digitsStart {
+		return "", 0, false
+	}
+	unitEnd := j
+	if unitEnd < len(input) && input[unitEnd] == 'm' && unitEnd+1 < len(input) && input[unitEnd+1] == 's' {
+		unitEnd += 2
+	} else if unitEnd < len(input) && strings.ContainsRune("smhdwy", rune(input[unitEnd])) {
+		unitEnd++
+	} else {
+		return "", 0, false
+	}
+	if unitEnd < len(input) && isAlphaNumeric(rune(input[unitEnd])) {
+		return "", 0, false
+	}
+	return input[at:unitEnd], unitEnd, true
+}
+
+// applyNegativeOffsets attaches the offsets found by
+// preprocessNegativeOffsets to the VectorSelector or MatrixSelector each one
+// immediately follows in final (the string that was actually parsed), using
+// the same whitespace-only-gap rule as applyAtModifiers.
+func applyNegativeOffsets(expr Expr, final string, clauses []negOffsetClause) error {
+	type candidate struct {
+		end Pos
+		vs  *VectorSelector
+	}
+	var candidates []candidate
+
+	Inspect(expr, func(node Node, _ []Node) error {
+		switch n := node.(type) {
+		case *VectorSelector:
+			candidates = append(candidates, candidate{end: n.PosRange.End, vs: n})
+		case *MatrixSelector:
+			if vs, ok := n.VectorSelector.(*VectorSelector); ok {
+				candidates = append(candidates, candidate{end: n.EndPos, vs: vs})
+			}
+		}
+		return nil
+	})
+
+	for _, cl := range clauses {
+		var best *candidate
+		for i := range candidates {
+			c := &candidates[i]
+			if c.end > cl.pos {
+				continue
+			}
+			if best != nil && c.end <= best.end {
+				continue
+			}
+			if strings.TrimFunc(final[c.end:cl.pos], isSpace) != "" {
+				continue
+			}
+			best = c
+		}
+		if best == nil {
+			return errors.New("offset modifier must be preceded by an instant or range selector")
+		}
+		if best.vs.Offset != 0 {
+			return errors.New("offset may not be set multiple times")
+		}
+		best.vs.Offset = cl.offset
+	}
+	return nil
+}
@@ -103,6 +103,38 @@ func (errs ParseErrors) Error() string {
 
 // ParseExpr returns the expression parsed from the input.
 func ParseExpr(input string) (expr Expr, err error) {
+	// preprocessGroupingPatterns runs first because it re-attaches by name
+	// rather than by position: unlike the folds below, it can't be thrown
+	// off by a later pass changing the string length before a recorded
+	// position.
+	input, groupingPatterns, err := preprocessGroupingPatterns(input)
+	if err != nil {
+		return nil, err
+	}
+
+	input, negOffsetClauses, err := preprocessNegativeOffsets(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Both folds below can change input's length before the positions
+	// preprocessNegativeOffsets just recorded, so those positions are
+	// carried through each one and re-expressed in its output's coordinate
+	// space, keeping them valid in the string that is actually parsed.
+	negOffsetPositions := negOffsetClausePositions(negOffsetClauses)
+
+	input, negOffsetPositions, err = preprocessDurationExpressions(input, negOffsetPositions)
+	if err != nil {
+		return nil, err
+	}
+
+	input, atClauses, negOffsetPositions, err := preprocessAtModifiers(input, negOffsetPositions)
+	if err != nil {
+		return nil, err
+	}
+
+	setNegOffsetClausePositions(negOffsetClauses, negOffsetPositions)
+
 	p := newParser(input)
 	defer parserPool.Put(p)
 	defer p.recover(&err)
@@ -122,6 +154,18 @@ func ParseExpr(input string) (expr Expr, err error) {
 		err = p.parseErrors
 	}
 
+	if err == nil && len(negOffsetClauses) > 0 {
+		err = applyNegativeOffsets(expr, input, negOffsetClauses)
+	}
+
+	if err == nil && len(atClauses) > 0 {
+		err = applyAtModifiers(expr, input, atClauses)
+	}
+
+	if err == nil && len(groupingPatterns) > 0 {
+		applyGroupingPatterns(expr, groupingPatterns)
+	}
+
 	return expr, err
 }
 
@@ -463,9 +507,16 @@ func (p *parser) checkAST(node Node) (typ ValueType) {
 			p.addParseErrf(n.PositionRange(), "aggregation operator expected in aggregation expression but got %q", n.Op)
 		}
 		p.expectType(n.Expr, ValueTypeVector, "aggregation expression")
-		if n.Op == TOPK || n.Op == BOTTOMK || n.Op == QUANTILE {
+		if n.Op == QUANTILE {
 			p.expectType(n.Param, ValueTypeScalar, "aggregation parameter")
 		}
+		if n.Op == TOPK || n.Op == BOTTOMK {
+			// topk/bottomk additionally accept an instant vector, so k can
+			// vary per group (e.g. topk(count(up) by (job), up) by (job)).
+			if t := p.checkAST(n.Param); t != ValueTypeScalar && t != ValueTypeVector {
+				p.addParseErrf(n.Param.PositionRange(), "expected type %s or %s in aggregation parameter, got %s", DocumentedType(ValueTypeScalar), DocumentedType(ValueTypeVector), DocumentedType(t))
+			}
+		}
 		if n.Op == COUNT_VALUES {
 			p.expectType(n.Param, ValueTypeString, "aggregation parameter")
 		}
@@ -2007,9 +2007,36 @@ var testExpr = []struct {
 		fail:   true,
 		errMsg: "trailing commas not allowed in function call args",
 	}, {
-		input:  `topk(some_metric, other_metric)`,
-		fail:   true,
-		errMsg: "1:6: parse error: expected type scalar in aggregation parameter, got instant vector",
+		// topk/bottomk additionally accept an instant vector parameter, so
+		// per-group k can come from another aggregated expression.
+		input: `topk(some_metric, other_metric)`,
+		expected: &AggregateExpr{
+			Op: TOPK,
+			Expr: &VectorSelector{
+				Name: "other_metric",
+				LabelMatchers: []*labels.Matcher{
+					mustLabelMatcher(labels.MatchEqual, string(model.MetricNameLabel), "other_metric"),
+				},
+				PosRange: PositionRange{
+					Start: 18,
+					End:   30,
+				},
+			},
+			Param: &VectorSelector{
+				Name: "some_metric",
+				LabelMatchers: []*labels.Matcher{
+					mustLabelMatcher(labels.MatchEqual, string(model.MetricNameLabel), "some_metric"),
+				},
+				PosRange: PositionRange{
+					Start: 5,
+					End:   16,
+				},
+			},
+			PosRange: PositionRange{
+				Start: 0,
+				End:   31,
+			},
+		},
 	}, {
 		input:  `count_values(5, other_metric)`,
 		fail:   true,
@@ -2740,6 +2767,245 @@ func TestParseSeries(t *testing.T) {
 	}
 }
 
+func TestDurationExpressions(t *testing.T) {
+	for _, test := range []struct {
+		input       string
+		expectRange time.Duration
+		expectOff   time.Duration
+		fail        bool
+	}{
+		{input: "foo[2 * 1h]", expectRange: 2 * time.Hour},
+		{input: "foo[1h - 5m]", expectRange: 55 * time.Minute},
+		{input: "foo[2 * (1h - 30m)]", expectRange: time.Hour},
+		{input: "foo offset (1d + 2h)", expectOff: 26 * time.Hour},
+		{input: "foo[5m:1m]", expectRange: 5 * time.Minute},
+		{input: "foo[1h / 0]", fail: true},
+		{input: "foo[2 * $__interval]", fail: true},
+	} {
+		expr, err := ParseExpr(test.input)
+		if test.fail {
+			testutil.NotOk(t, err, "expected error for %q", test.input)
+			continue
+		}
+		testutil.Ok(t, err)
+
+		switch n := expr.(type) {
+		case *MatrixSelector:
+			testutil.Equals(t, test.expectRange, n.Range, "wrong range for %q", test.input)
+		case *SubqueryExpr:
+			testutil.Equals(t, test.expectRange, n.Range, "wrong range for %q", test.input)
+		case *VectorSelector:
+			testutil.Equals(t, test.expectOff, n.Offset, "wrong offset for %q", test.input)
+		default:
+			t.Fatalf("unexpected expression type %T for %q", expr, test.input)
+		}
+	}
+}
+
+func TestAtModifiers(t *testing.T) {
+	for _, test := range []struct {
+		input          string
+		expectTs       *int64
+		expectSoE      StartOrEnd
+		fail           bool
+		errMsgContains string
+	}{
+		{input: "foo @ 1609746900", expectTs: int64p(1609746900000)},
+		{input: "foo @ 1609746900.5", expectTs: int64p(1609746900500)},
+		{input: "foo[5m] @ 1609746900", expectTs: int64p(1609746900000)},
+		{input: "foo offset 5m @ 1609746900", expectTs: int64p(1609746900000)},
+		{input: "foo @ start()", expectSoE: StartModifier},
+		{input: "foo @ end()", expectSoE: EndModifier},
+		{input: "rate(foo[5m] @ 1609746900)", expectTs: int64p(1609746900000)},
+		{input: "sum(foo) @ 1609746900", fail: true, errMsgContains: "@ modifier must be preceded by"},
+		{input: "foo @ 1609746900 @ 1609746901", fail: true, errMsgContains: "@ may not be set multiple times"},
+		{input: "foo @ $__interval", fail: true},
+	} {
+		expr, err := ParseExpr(test.input)
+		if test.fail {
+			testutil.NotOk(t, err, "expected error for %q", test.input)
+			if test.errMsgContains != "" {
+				testutil.Assert(t, strings.Contains(err.Error(), test.errMsgContains), "expected error for %q to contain %q, got %q", test.input, test.errMsgContains, err.Error())
+			}
+			continue
+		}
+		testutil.Ok(t, err)
+
+		var vs *VectorSelector
+		switch n := expr.(type) {
+		case *VectorSelector:
+			vs = n
+		case *MatrixSelector:
+			vs = n.VectorSelector.(*VectorSelector)
+		case *Call:
+			vs = n.Args[0].(*MatrixSelector).VectorSelector.(*VectorSelector)
+		default:
+			t.Fatalf("unexpected expression type %T for %q", expr, test.input)
+		}
+
+		if test.expectTs != nil {
+			testutil.Assert(t, vs.Timestamp != nil, "expected a timestamp for %q", test.input)
+			testutil.Equals(t, *test.expectTs, *vs.Timestamp, "wrong timestamp for %q", test.input)
+		} else {
+			testutil.Equals(t, test.expectSoE, vs.StartOrEnd, "wrong @ start()/end() for %q", test.input)
+		}
+	}
+}
+
+func int64p(i int64) *int64 { return &i }
+
+func TestLabelMatchingGrouping(t *testing.T) {
+	prev := EnableLabelMatchingGrouping
+	EnableLabelMatchingGrouping = true
+	defer func() { EnableLabelMatchingGrouping = prev }()
+
+	for _, test := range []struct {
+		input          string
+		expectGrouping []string
+		expectPatterns []string
+		fail           bool
+		errMsgContains string
+	}{
+		{
+			input:          `sum by (pod, label_matching("app_.*")) (metric)`,
+			expectGrouping: []string{"pod"},
+			expectPatterns: []string{"app_.*"},
+		},
+		{
+			input:          `sum without (label_matching("app_.*")) (metric)`,
+			expectGrouping: []string{},
+			expectPatterns: []string{"app_.*"},
+		},
+		{
+			input:          `sum by (label_matching("a.*"), label_matching("b.*")) (metric)`,
+			expectGrouping: []string{},
+			expectPatterns: []string{"a.*", "b.*"},
+		},
+		{input: `sum by (label_matching("(")) (metric)`, fail: true, errMsgContains: "invalid regular expression"},
+		{input: `sum by (label_matching(foo)) (metric)`, fail: true, errMsgContains: "quoted regular expression"},
+	} {
+		expr, err := ParseExpr(test.input)
+		if test.fail {
+			testutil.NotOk(t, err, "expected error for %q", test.input)
+			if test.errMsgContains != "" {
+				testutil.Assert(t, strings.Contains(err.Error(), test.errMsgContains), "expected error for %q to contain %q, got %q", test.input, test.errMsgContains, err.Error())
+			}
+			continue
+		}
+		testutil.Ok(t, err)
+
+		agg, ok := expr.(*AggregateExpr)
+		testutil.Assert(t, ok, "expected an AggregateExpr for %q, got %T", test.input, expr)
+		testutil.Equals(t, test.expectGrouping, agg.Grouping, "wrong Grouping for %q", test.input)
+		testutil.Equals(t, test.expectPatterns, agg.GroupingPatterns, "wrong GroupingPatterns for %q", test.input)
+	}
+}
+
+func TestLabelMatchingGroupingDisabled(t *testing.T) {
+	testutil.Assert(t, !EnableLabelMatchingGrouping, "expected label_matching() grouping to be disabled by default")
+	_, err := ParseExpr(`sum by (label_matching("a.*")) (metric)`)
+	testutil.NotOk(t, err, "expected error when label_matching() grouping is disabled")
+	testutil.Assert(t, strings.Contains(err.Error(), "must be enabled with --enable-feature=promql-label-matching-grouping"), "wrong error: %s", err.Error())
+}
+
+func TestNegativeOffset(t *testing.T) {
+	prev := EnableNegativeOffset
+	EnableNegativeOffset = true
+	defer func() { EnableNegativeOffset = prev }()
+
+	for _, test := range []struct {
+		input          string
+		expectOffset   time.Duration
+		fail           bool
+		errMsgContains string
+	}{
+		{input: "foo offset -5m", expectOffset: -5 * time.Minute},
+		{input: "foo offset 0s", expectOffset: 0},
+		{input: "foo offset (-5m)", expectOffset: -5 * time.Minute},
+		{input: "foo offset (1h - 2h)", expectOffset: -time.Hour},
+		{input: "foo offset -5m @ 1609746900", expectOffset: -5 * time.Minute},
+		{input: "sum(foo) offset -5m", fail: true, errMsgContains: "offset modifier must be preceded by"},
+		{input: "foo offset -5m offset -1m", fail: true, errMsgContains: "offset may not be set multiple times"},
+	} {
+		expr, err := ParseExpr(test.input)
+		if test.fail {
+			testutil.NotOk(t, err, "expected error for %q", test.input)
+			if test.errMsgContains != "" {
+				testutil.Assert(t, strings.Contains(err.Error(), test.errMsgContains), "expected error for %q to contain %q, got %q", test.input, test.errMsgContains, err.Error())
+			}
+			continue
+		}
+		testutil.Ok(t, err)
+
+		vs, ok := expr.(*VectorSelector)
+		testutil.Assert(t, ok, "expected a VectorSelector for %q, got %T", test.input, expr)
+		testutil.Equals(t, test.expectOffset, vs.Offset, "wrong offset for %q", test.input)
+	}
+}
+
+func TestNegativeOffsetDisabled(t *testing.T) {
+	testutil.Assert(t, !EnableNegativeOffset, "expected negative offset to be disabled by default")
+	_, err := ParseExpr(`foo offset -5m`)
+	testutil.NotOk(t, err, "expected error when negative offset is disabled")
+	testutil.Assert(t, strings.Contains(err.Error(), "must be enabled with --enable-feature=promql-negative-offset"), "wrong error: %s", err.Error())
+}
+
+// TestNegativeOffsetWithLabelMatchingGrouping guards against the two
+// textual pre-lexing folds invalidating each other's recorded positions:
+// preprocessGroupingPatterns must run before the position-based
+// preprocessNegativeOffsets/preprocessAtModifiers folds record positions
+// against the final, fully-folded query string.
+func TestNegativeOffsetWithLabelMatchingGrouping(t *testing.T) {
+	prevOffset, prevGrouping := EnableNegativeOffset, EnableLabelMatchingGrouping
+	EnableNegativeOffset, EnableLabelMatchingGrouping = true, true
+	defer func() { EnableNegativeOffset, EnableLabelMatchingGrouping = prevOffset, prevGrouping }()
+
+	expr, err := ParseExpr(`sum by (label_matching("app_.*")) (foo offset -5m @ 100)`)
+	testutil.Ok(t, err)
+
+	agg, ok := expr.(*AggregateExpr)
+	testutil.Assert(t, ok, "expected an AggregateExpr, got %T", expr)
+	testutil.Equals(t, []string{"app_.*"}, agg.GroupingPatterns)
+
+	vs, ok := agg.Expr.(*VectorSelector)
+	testutil.Assert(t, ok, "expected a VectorSelector, got %T", agg.Expr)
+	testutil.Equals(t, -5*time.Minute, vs.Offset)
+	testutil.Assert(t, vs.Timestamp != nil, "expected a timestamp")
+	testutil.Equals(t, int64(100000), *vs.Timestamp)
+}
+
+// TestNegativeOffsetWithLaterFolds guards against the position
+// preprocessNegativeOffsets records against its own output going stale once
+// preprocessDurationExpressions and preprocessAtModifiers -- which both run
+// afterwards and can change the string's length before that position --
+// have had their turn.
+func TestNegativeOffsetWithLaterFolds(t *testing.T) {
+	prev := EnableNegativeOffset
+	EnableNegativeOffset = true
+	defer func() { EnableNegativeOffset = prev }()
+
+	// foo[(5m+30s)] offset -1m: preprocessDurationExpressions folds the
+	// range selector's "(5m+30s)" down to "5m30s", shortening the string
+	// before the negative offset's recorded position.
+	expr, err := ParseExpr(`foo[(5m+30s)] offset -1m`)
+	testutil.Ok(t, err)
+	ms, ok := expr.(*MatrixSelector)
+	testutil.Assert(t, ok, "expected a MatrixSelector, got %T", expr)
+	vs, ok := ms.VectorSelector.(*VectorSelector)
+	testutil.Assert(t, ok, "expected a VectorSelector, got %T", ms.VectorSelector)
+	testutil.Equals(t, -time.Minute, vs.Offset)
+
+	// foo @ start() offset -5m: preprocessAtModifiers runs after the
+	// negative-offset fold, so the offset's recorded position must still
+	// point at the right place once it, too, has rewritten the string.
+	expr, err = ParseExpr(`foo @ start() offset -5m`)
+	testutil.Ok(t, err)
+	vs, ok = expr.(*VectorSelector)
+	testutil.Assert(t, ok, "expected a VectorSelector, got %T", expr)
+	testutil.Equals(t, -5*time.Minute, vs.Offset)
+	testutil.Equals(t, StartModifier, vs.StartOrEnd)
+}
+
 func TestRecoverParserRuntime(t *testing.T) {
 	p := newParser("foo bar")
 	var err error
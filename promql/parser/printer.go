@@ -64,10 +64,10 @@ func (node *AggregateExpr) String() string {
 	aggrString := node.Op.String()
 
 	if node.Without {
-		aggrString += fmt.Sprintf(" without(%s) ", strings.Join(node.Grouping, ", "))
+		aggrString += fmt.Sprintf(" without(%s) ", node.groupingString())
 	} else {
-		if len(node.Grouping) > 0 {
-			aggrString += fmt.Sprintf(" by(%s) ", strings.Join(node.Grouping, ", "))
+		if len(node.Grouping) > 0 || len(node.GroupingPatterns) > 0 {
+			aggrString += fmt.Sprintf(" by(%s) ", node.groupingString())
 		}
 	}
 
@@ -80,6 +80,16 @@ func (node *AggregateExpr) String() string {
 	return aggrString
 }
 
+// groupingString renders the grouping clause's labels and
+// `label_matching("<regex>")` patterns, e.g. "pod, label_matching(\"app_.*\")".
+func (node *AggregateExpr) groupingString() string {
+	parts := append([]string{}, node.Grouping...)
+	for _, p := range node.GroupingPatterns {
+		parts = append(parts, fmt.Sprintf("label_matching(%q)", p))
+	}
+	return strings.Join(parts, ", ")
+}
+
 func (node *BinaryExpr) String() string {
 	returnBool := ""
 	if node.ReturnBool {
@@ -118,11 +128,29 @@ func (node *MatrixSelector) String() string {
 	if vecSelector.Offset != time.Duration(0) {
 		offset = fmt.Sprintf(" offset %s", model.Duration(vecSelector.Offset))
 	}
+	at := atModifierString(vecSelector.Timestamp, vecSelector.StartOrEnd)
 
-	// Do not print the offset twice.
+	// Do not print the offset/@ twice.
 	vecSelector.Offset = 0
+	vecSelector.Timestamp = nil
+	vecSelector.StartOrEnd = NoStartOrEnd
 
-	return fmt.Sprintf("%s[%s]%s", vecSelector.String(), model.Duration(node.Range), offset)
+	return fmt.Sprintf("%s[%s]%s%s", vecSelector.String(), model.Duration(node.Range), offset, at)
+}
+
+// atModifierString formats the `@` modifier of a selector, or "" if it has
+// none.
+func atModifierString(ts *int64, startOrEnd StartOrEnd) string {
+	switch {
+	case ts != nil:
+		return fmt.Sprintf(" @ %.3f", float64(*ts)/1000)
+	case startOrEnd == StartModifier:
+		return " @ start()"
+	case startOrEnd == EndModifier:
+		return " @ end()"
+	default:
+		return ""
+	}
 }
 
 func (node *SubqueryExpr) String() string {
@@ -166,10 +194,11 @@ func (node *VectorSelector) String() string {
 	if node.Offset != time.Duration(0) {
 		offset = fmt.Sprintf(" offset %s", model.Duration(node.Offset))
 	}
+	at := atModifierString(node.Timestamp, node.StartOrEnd)
 
 	if len(labelStrings) == 0 {
-		return fmt.Sprintf("%s%s", node.Name, offset)
+		return fmt.Sprintf("%s%s%s", node.Name, offset, at)
 	}
 	sort.Strings(labelStrings)
-	return fmt.Sprintf("%s{%s}%s", node.Name, strings.Join(labelStrings, ","), offset)
+	return fmt.Sprintf("%s{%s}%s%s", node.Name, strings.Join(labelStrings, ","), offset, at)
 }
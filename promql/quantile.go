@@ -70,7 +70,14 @@ type metricWithBuckets struct {
 // If q<0, -Inf is returned.
 //
 // If q>1, +Inf is returned.
-func bucketQuantile(q float64, buckets buckets) float64 {
+//
+// method selects how the rank is turned into a value within the bucket that
+// contains it: interpolationLinear (the default) linearly interpolates
+// between the bucket's lower and upper bound, while interpolationLowerBound
+// ignores the rank within the bucket and always returns the bucket's lower
+// bound, which is a more conservative (and cheaper) estimate that never
+// overstates the quantile.
+func bucketQuantile(q float64, buckets buckets, method quantileInterpolationMethod) float64 {
 	if q < 0 {
 		return math.Inf(-1)
 	}
@@ -111,9 +118,95 @@ func bucketQuantile(q float64, buckets buckets) float64 {
 		count -= buckets[b-1].count
 		rank -= buckets[b-1].count
 	}
+	if method == interpolationLowerBound {
+		return bucketStart
+	}
 	return bucketStart + (bucketEnd-bucketStart)*(rank/count)
 }
 
+// quantileInterpolationMethod selects how bucketQuantile turns a rank within
+// a bucket into a concrete value.
+type quantileInterpolationMethod string
+
+const (
+	// interpolationLinear linearly interpolates between a bucket's lower and
+	// upper bound, assuming observations are evenly distributed within it.
+	// This is the long-standing default behavior of histogram_quantile.
+	interpolationLinear quantileInterpolationMethod = "linear"
+	// interpolationLowerBound always returns a bucket's lower bound, never
+	// overstating the quantile; this matches the way exponential-bucket
+	// native histograms report a lower-bound estimate instead of
+	// interpolating within a bucket.
+	interpolationLowerBound quantileInterpolationMethod = "lower-bound"
+)
+
+// bucketCount returns the total number of observations recorded across
+// buckets, i.e. the count in its +Inf bucket. It returns NaN under the same
+// conditions as bucketQuantile (fewer than 2 buckets, or no +Inf bucket).
+func bucketCount(buckets buckets) float64 {
+	sort.Sort(buckets)
+	if !math.IsInf(buckets[len(buckets)-1].upperBound, +1) {
+		return math.NaN()
+	}
+
+	buckets = coalesceBuckets(buckets)
+	ensureMonotonic(buckets)
+
+	if len(buckets) < 2 {
+		return math.NaN()
+	}
+	return buckets[len(buckets)-1].count
+}
+
+// bucketFraction estimates the fraction of observations in buckets that fall
+// within [lower, upper], linearly interpolating within whichever bucket each
+// bound falls into -- the same assumption bucketQuantile makes in the other
+// direction. It returns NaN under the same conditions as bucketQuantile.
+func bucketFraction(lower, upper float64, buckets buckets) float64 {
+	if lower > upper {
+		lower, upper = upper, lower
+	}
+	sort.Sort(buckets)
+	if !math.IsInf(buckets[len(buckets)-1].upperBound, +1) {
+		return math.NaN()
+	}
+
+	buckets = coalesceBuckets(buckets)
+	ensureMonotonic(buckets)
+
+	if len(buckets) < 2 {
+		return math.NaN()
+	}
+	total := buckets[len(buckets)-1].count
+	if total == 0 {
+		return math.NaN()
+	}
+	return (rankBelow(buckets, upper) - rankBelow(buckets, lower)) / total
+}
+
+// rankBelow linearly interpolates the number of observations at or below x
+// within already sorted, coalesced and monotonic buckets.
+func rankBelow(buckets buckets, x float64) float64 {
+	if math.IsInf(x, -1) {
+		return 0
+	}
+	if math.IsInf(x, +1) {
+		return buckets[len(buckets)-1].count
+	}
+
+	lowerBound, lowerCount := 0.0, 0.0
+	for _, b := range buckets {
+		if x <= b.upperBound {
+			if b.upperBound <= lowerBound {
+				return lowerCount
+			}
+			return lowerCount + (b.count-lowerCount)*(x-lowerBound)/(b.upperBound-lowerBound)
+		}
+		lowerBound, lowerCount = b.upperBound, b.count
+	}
+	return buckets[len(buckets)-1].count
+}
+
 // coalesceBuckets merges buckets with the same upper bound.
 //
 // The input buckets must be sorted.
@@ -93,6 +93,12 @@ type Alert struct {
 	ResolvedAt time.Time
 	LastSentAt time.Time
 	ValidUntil time.Time
+
+	// HoldDuration is the duration this alert had to persist in the
+	// expression output vector before transitioning from Pending to Firing,
+	// resolved from the rule's for_template if set, or else the rule's
+	// static for duration.
+	HoldDuration time.Duration
 }
 
 func (a *Alert) needsSending(ts time.Time, resendDelay time.Duration) bool {
@@ -117,6 +123,11 @@ type AlertingRule struct {
 	// The duration for which a labelset needs to persist in the expression
 	// output vector before an alert transitions from Pending to Firing state.
 	holdDuration time.Duration
+	// If non-empty, a template that overrides holdDuration on a per-alert
+	// basis, expanded against the alert's labels like the labels and
+	// annotations below. Lets a single rule shared across SLO tiers, say,
+	// use a different for duration per tier without duplicating the rule.
+	forTemplate string
 	// Extra labels to attach to the resulting alert sample vectors.
 	labels labels.Labels
 	// Non-identifying key/value pairs.
@@ -290,6 +301,12 @@ func (r *AlertingRule) SetRestored(restored bool) {
 	r.restored = restored
 }
 
+// SetForTemplate sets the template used to resolve a per-alert hold duration,
+// overriding the rule's static for duration when it expands to a valid one.
+func (r *AlertingRule) SetForTemplate(tmpl string) {
+	r.forTemplate = tmpl
+}
+
 // resolvedRetention is the duration for which a resolved alert instance
 // is kept in memory state and consequently repeatedly sent to the AlertManager.
 const resolvedRetention = 15 * time.Minute
@@ -372,12 +389,22 @@ func (r *AlertingRule) Eval(ctx context.Context, ts time.Time, query QueryFunc,
 			return nil, err
 		}
 
+		holdDuration := r.holdDuration
+		if r.forTemplate != "" {
+			if d, err := model.ParseDuration(expand(r.forTemplate)); err == nil {
+				holdDuration = time.Duration(d)
+			} else {
+				level.Warn(r.logger).Log("msg", "Expanding for_template failed, using rule's static for duration", "err", err, "for_template", r.forTemplate)
+			}
+		}
+
 		alerts[h] = &Alert{
-			Labels:      lbs,
-			Annotations: annotations,
-			ActiveAt:    ts,
-			State:       StatePending,
-			Value:       smpl.V,
+			Labels:       lbs,
+			Annotations:  annotations,
+			ActiveAt:     ts,
+			State:        StatePending,
+			Value:        smpl.V,
+			HoldDuration: holdDuration,
 		}
 	}
 
@@ -408,7 +435,7 @@ func (r *AlertingRule) Eval(ctx context.Context, ts time.Time, query QueryFunc,
 			continue
 		}
 
-		if a.State == StatePending && ts.Sub(a.ActiveAt) >= r.holdDuration {
+		if a.State == StatePending && ts.Sub(a.ActiveAt) >= a.HoldDuration {
 			a.State = StateFiring
 			a.FiredAt = ts
 		}
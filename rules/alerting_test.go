@@ -147,6 +147,54 @@ func TestAlertingRuleLabelsUpdate(t *testing.T) {
 	}
 }
 
+func TestAlertingRuleForTemplate(t *testing.T) {
+	suite, err := promql.NewTest(t, `
+		load 1m
+			http_requests{job="fast", instance="0"}	75 75 75 75
+			http_requests{job="slow", instance="0"}	75 75 75 75
+	`)
+	testutil.Ok(t, err)
+	defer suite.Close()
+
+	testutil.Ok(t, suite.Run())
+
+	expr, err := parser.ParseExpr(`http_requests < 100`)
+	testutil.Ok(t, err)
+
+	rule := NewAlertingRule(
+		"HTTPRequestRateLow",
+		expr,
+		time.Hour,
+		labels.FromStrings("severity", "page"),
+		nil, nil, true, nil,
+	)
+	// Per job, override the rule's 1h static for with a much shorter duration
+	// for "fast" and keep the slow tier well above the evaluation window.
+	rule.SetForTemplate(`{{ if eq $labels.job "fast" }}1m{{ else }}24h{{ end }}`)
+
+	baseTime := time.Unix(0, 0)
+	var gotFast, gotSlow bool
+	for i := 0; i < 3; i++ {
+		evalTime := baseTime.Add(time.Duration(i) * time.Minute)
+		res, err := rule.Eval(suite.Context(), evalTime, EngineQueryFunc(suite.QueryEngine(), suite.Storage()), nil)
+		testutil.Ok(t, err)
+
+		for _, smpl := range res {
+			if smpl.Metric.Get("__name__") != "ALERTS" {
+				continue
+			}
+			switch smpl.Metric.Get("job") {
+			case "fast":
+				gotFast = smpl.Metric.Get("alertstate") == "firing"
+			case "slow":
+				gotSlow = smpl.Metric.Get("alertstate") == "firing"
+			}
+		}
+	}
+	testutil.Assert(t, gotFast, "expected the fast tier to have fired given its templated 1m for duration")
+	testutil.Assert(t, !gotSlow, "expected the slow tier to still be pending given its templated 24h for duration")
+}
+
 func TestAlertingRuleExternalLabelsInTemplate(t *testing.T) {
 	suite, err := promql.NewTest(t, `
 		load 1m
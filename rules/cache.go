@@ -0,0 +1,192 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/prometheus/prometheus/tsdb/chunkenc"
+	"github.com/prometheus/prometheus/tsdb/tsdbutil"
+)
+
+// selectCache caches the materialized result of Select calls made while
+// evaluating the rules of a single group at a single timestamp, so that
+// rules sharing an identical selector (e.g. several recording rules reading
+// the same underlying metric) only read the series once per group
+// evaluation.
+type selectCache struct {
+	mtx   sync.Mutex
+	items map[string]*materializedSeriesSet
+}
+
+type selectCacheContextKey struct{}
+
+// withSelectCache returns a context carrying a fresh selectCache. The cache
+// is only ever populated and read within the lifetime of that context, so
+// callers must create a new one for each group evaluation cycle.
+func withSelectCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, selectCacheContextKey{}, &selectCache{
+		items: map[string]*materializedSeriesSet{},
+	})
+}
+
+func selectCacheFromContext(ctx context.Context) *selectCache {
+	c, _ := ctx.Value(selectCacheContextKey{}).(*selectCache)
+	return c
+}
+
+// maybeCachingQueryable wraps q with a Select cache if ctx carries one, and
+// returns q unchanged otherwise.
+func maybeCachingQueryable(ctx context.Context, q storage.Queryable) storage.Queryable {
+	cache := selectCacheFromContext(ctx)
+	if cache == nil {
+		return q
+	}
+	return &cachingQueryable{Queryable: q, cache: cache}
+}
+
+type cachingQueryable struct {
+	storage.Queryable
+	cache *selectCache
+}
+
+func (q *cachingQueryable) Querier(ctx context.Context, mint, maxt int64) (storage.Querier, error) {
+	querier, err := q.Queryable.Querier(ctx, mint, maxt)
+	if err != nil {
+		return nil, err
+	}
+	return &cachingQuerier{Querier: querier, cache: q.cache, mint: mint, maxt: maxt}, nil
+}
+
+type cachingQuerier struct {
+	storage.Querier
+	cache      *selectCache
+	mint, maxt int64
+}
+
+func (q *cachingQuerier) Select(sortSeries bool, hints *storage.SelectHints, matchers ...*labels.Matcher) storage.SeriesSet {
+	key := q.selectCacheKey(sortSeries, hints, matchers)
+
+	q.cache.mtx.Lock()
+	mss, ok := q.cache.items[key]
+	q.cache.mtx.Unlock()
+	if ok {
+		return mss.cursor()
+	}
+
+	mss = materializeSeriesSet(q.Querier.Select(sortSeries, hints, matchers...))
+
+	q.cache.mtx.Lock()
+	q.cache.items[key] = mss
+	q.cache.mtx.Unlock()
+
+	return mss.cursor()
+}
+
+func (q *cachingQuerier) selectCacheKey(sortSeries bool, hints *storage.SelectHints, matchers []*labels.Matcher) string {
+	ms := make([]string, len(matchers))
+	for i, m := range matchers {
+		ms[i] = m.String()
+	}
+	sort.Strings(ms)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d:%d:%t:", q.mint, q.maxt, sortSeries)
+	if hints != nil {
+		fmt.Fprintf(&sb, "%d,%d,%s,%v,%t,%d:", hints.Start, hints.End, hints.Func, hints.Grouping, hints.By, hints.Range)
+	}
+	sb.WriteString(strings.Join(ms, ","))
+	return sb.String()
+}
+
+// materializedSeriesSet holds a Select result fully read into memory, so it
+// can be handed out to multiple independent cursors.
+type materializedSeriesSet struct {
+	series   []*materializedSeries
+	warnings storage.Warnings
+	err      error
+}
+
+func materializeSeriesSet(ss storage.SeriesSet) *materializedSeriesSet {
+	mss := &materializedSeriesSet{}
+	for ss.Next() {
+		s := ss.At()
+		it := s.Iterator()
+		var samples []tsdbutil.Sample
+		for it.Next() {
+			t, v := it.At()
+			samples = append(samples, materializedSample{t: t, v: v})
+		}
+		if it.Err() != nil {
+			mss.err = it.Err()
+			return mss
+		}
+		mss.series = append(mss.series, &materializedSeries{lset: s.Labels(), samples: samples})
+	}
+	mss.err = ss.Err()
+	mss.warnings = ss.Warnings()
+	return mss
+}
+
+func (mss *materializedSeriesSet) cursor() storage.SeriesSet {
+	return &materializedSeriesSetCursor{mss: mss, idx: -1}
+}
+
+type materializedSeriesSetCursor struct {
+	mss *materializedSeriesSet
+	idx int
+}
+
+func (c *materializedSeriesSetCursor) Next() bool {
+	c.idx++
+	return c.idx < len(c.mss.series)
+}
+
+func (c *materializedSeriesSetCursor) At() storage.Series {
+	return c.mss.series[c.idx]
+}
+
+func (c *materializedSeriesSetCursor) Err() error { return c.mss.err }
+
+func (c *materializedSeriesSetCursor) Warnings() storage.Warnings { return c.mss.warnings }
+
+type materializedSeries struct {
+	lset    labels.Labels
+	samples []tsdbutil.Sample
+}
+
+func (s *materializedSeries) Labels() labels.Labels { return s.lset }
+
+func (s *materializedSeries) Iterator() chunkenc.Iterator {
+	return storage.NewListSeriesIterator(materializedSamples(s.samples))
+}
+
+type materializedSamples []tsdbutil.Sample
+
+func (s materializedSamples) Get(i int) tsdbutil.Sample { return s[i] }
+func (s materializedSamples) Len() int                  { return len(s) }
+
+type materializedSample struct {
+	t int64
+	v float64
+}
+
+func (s materializedSample) T() int64   { return s.t }
+func (s materializedSample) V() float64 { return s.v }
@@ -0,0 +1,96 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/prometheus/prometheus/util/teststorage"
+	"github.com/prometheus/prometheus/util/testutil"
+)
+
+// countingQueryable wraps a storage.Queryable and counts every Select call
+// made against it, so tests can assert whether the cache avoided a read.
+type countingQueryable struct {
+	storage.Queryable
+	selects int64
+}
+
+func (q *countingQueryable) Querier(ctx context.Context, mint, maxt int64) (storage.Querier, error) {
+	querier, err := q.Queryable.Querier(ctx, mint, maxt)
+	if err != nil {
+		return nil, err
+	}
+	return &countingQuerier{Querier: querier, q: q}, nil
+}
+
+type countingQuerier struct {
+	storage.Querier
+	q *countingQueryable
+}
+
+func (q *countingQuerier) Select(sortSeries bool, hints *storage.SelectHints, matchers ...*labels.Matcher) storage.SeriesSet {
+	atomic.AddInt64(&q.q.selects, 1)
+	return q.Querier.Select(sortSeries, hints, matchers...)
+}
+
+func TestSelectCache_DeduplicatesIdenticalSelects(t *testing.T) {
+	st := teststorage.New(t)
+	defer st.Close()
+
+	app := st.Appender()
+	_, err := app.Add(labels.FromStrings("__name__", "a"), 0, 1)
+	testutil.Ok(t, err)
+	testutil.Ok(t, app.Commit())
+
+	cq := &countingQueryable{Queryable: st}
+	engine := promql.NewEngine(promql.EngineOpts{MaxSamples: 10, Timeout: 10 * time.Second})
+	queryFunc := EngineQueryFunc(engine, cq)
+
+	ctx := withSelectCache(context.Background())
+	_, err = queryFunc(ctx, "a", time.Unix(0, 0))
+	testutil.Ok(t, err)
+	_, err = queryFunc(ctx, "a", time.Unix(0, 0))
+	testutil.Ok(t, err)
+
+	testutil.Equals(t, int64(1), atomic.LoadInt64(&cq.selects))
+}
+
+func TestSelectCache_ScopedToContext(t *testing.T) {
+	st := teststorage.New(t)
+	defer st.Close()
+
+	app := st.Appender()
+	_, err := app.Add(labels.FromStrings("__name__", "a"), 0, 1)
+	testutil.Ok(t, err)
+	testutil.Ok(t, app.Commit())
+
+	cq := &countingQueryable{Queryable: st}
+	engine := promql.NewEngine(promql.EngineOpts{MaxSamples: 10, Timeout: 10 * time.Second})
+	queryFunc := EngineQueryFunc(engine, cq)
+
+	// No cache in context: every call reads storage again.
+	_, err = queryFunc(context.Background(), "a", time.Unix(0, 0))
+	testutil.Ok(t, err)
+	_, err = queryFunc(context.Background(), "a", time.Unix(0, 0))
+	testutil.Ok(t, err)
+
+	testutil.Equals(t, int64(2), atomic.LoadInt64(&cq.selects))
+}
@@ -168,11 +168,11 @@ type QueryFunc func(ctx context.Context, q string, t time.Time) (promql.Vector,
 // It converts scalar into vector results.
 func EngineQueryFunc(engine *promql.Engine, q storage.Queryable) QueryFunc {
 	return func(ctx context.Context, qs string, t time.Time) (promql.Vector, error) {
-		q, err := engine.NewInstantQuery(q, qs, t)
+		qry, err := engine.NewInstantQuery(maybeCachingQueryable(ctx, q), qs, t)
 		if err != nil {
 			return nil, err
 		}
-		res := q.Exec(ctx)
+		res := qry.Exec(ctx)
 		if res.Err != nil {
 			return nil, res.Err
 		}
@@ -236,6 +236,13 @@ type Group struct {
 
 	shouldRestore bool
 
+	// dryRun evaluates the group's rules every interval like normal, but
+	// suppresses any effect outside of the group itself: recording rules
+	// don't write samples and alerting rules don't send notifications. It
+	// allows a rule change to be observed safely before it is allowed to
+	// affect stored series or fire alerts.
+	dryRun bool
+
 	markStale   bool
 	done        chan struct{}
 	terminated  chan struct{}
@@ -252,6 +259,7 @@ type GroupOptions struct {
 	Rules         []Rule
 	ShouldRestore bool
 	Opts          *ManagerOptions
+	DryRun        bool
 	done          chan struct{}
 }
 
@@ -276,6 +284,7 @@ func NewGroup(o GroupOptions) *Group {
 		interval:             o.Interval,
 		rules:                o.Rules,
 		shouldRestore:        o.ShouldRestore,
+		dryRun:               o.DryRun,
 		opts:                 o.Opts,
 		seriesInPreviousEval: make([]map[string]labels.Labels, len(o.Rules)),
 		done:                 make(chan struct{}),
@@ -298,6 +307,10 @@ func (g *Group) Rules() []Rule { return g.rules }
 // Interval returns the group's interval.
 func (g *Group) Interval() time.Duration { return g.interval }
 
+// DryRun returns whether the group evaluates its rules without recording
+// results or sending alert notifications.
+func (g *Group) DryRun() bool { return g.dryRun }
+
 func (g *Group) run(ctx context.Context) {
 	defer close(g.terminated)
 
@@ -548,6 +561,12 @@ func (g *Group) CopyState(from *Group) {
 
 // Eval runs a single evaluation cycle in which all rules are evaluated sequentially.
 func (g *Group) Eval(ctx context.Context, ts time.Time) {
+	// Rules within the same group are evaluated at the same timestamp, so a
+	// selector one rule reads is safe to reuse for another rule within this
+	// cycle; scope the cache to this call so it never outlives the data it
+	// was read against.
+	ctx = withSelectCache(ctx)
+
 	for i, rule := range g.rules {
 		select {
 		case <-g.done:
@@ -580,7 +599,7 @@ func (g *Group) Eval(ctx context.Context, ts time.Time) {
 				return
 			}
 
-			if ar, ok := rule.(*AlertingRule); ok {
+			if ar, ok := rule.(*AlertingRule); ok && !g.dryRun {
 				ar.sendAlerts(ctx, ts, g.opts.ResendDelay, g.interval, g.opts.NotifyFunc)
 			}
 			var (
@@ -588,6 +607,14 @@ func (g *Group) Eval(ctx context.Context, ts time.Time) {
 				numDuplicates = 0
 			)
 
+			if g.dryRun {
+				// A dry-run group must not have any effect outside of its own
+				// evaluation: the rule's result is computed (so its health,
+				// duration and, for alerting rules, state are still visible
+				// through the API) but never written to storage.
+				return
+			}
+
 			app := g.opts.Appendable.Appender()
 			seriesReturned := make(map[string]labels.Labels, len(g.seriesInPreviousEval[i]))
 			defer func() {
@@ -753,10 +780,15 @@ func (g *Group) RestoreForState(ts time.Time) {
 				return
 			}
 
+			// Use this alert's own hold duration rather than the rule-level one:
+			// alertHoldDuration only gates whether restoration runs at all, but
+			// a.HoldDuration may have been templated per-labelset in Eval.
+			holdDuration := a.HoldDuration
+
 			downAt := time.Unix(t/1000, 0).UTC()
 			restoredActiveAt := time.Unix(int64(v), 0).UTC()
 			timeSpentPending := downAt.Sub(restoredActiveAt)
-			timeRemainingPending := alertHoldDuration - timeSpentPending
+			timeRemainingPending := holdDuration - timeSpentPending
 
 			if timeRemainingPending <= 0 {
 				// It means that alert was firing when prometheus went down.
@@ -764,18 +796,18 @@ func (g *Group) RestoreForState(ts time.Time) {
 				// firing again if it's still firing in that Eval.
 				// Nothing to be done in this case.
 			} else if timeRemainingPending < g.opts.ForGracePeriod {
-				// (new) restoredActiveAt = (ts + m.opts.ForGracePeriod) - alertHoldDuration
+				// (new) restoredActiveAt = (ts + m.opts.ForGracePeriod) - holdDuration
 				//                            /* new firing time */      /* moving back by hold duration */
 				//
 				// Proof of correctness:
-				// firingTime = restoredActiveAt.Add(alertHoldDuration)
-				//            = ts + m.opts.ForGracePeriod - alertHoldDuration + alertHoldDuration
+				// firingTime = restoredActiveAt.Add(holdDuration)
+				//            = ts + m.opts.ForGracePeriod - holdDuration + holdDuration
 				//            = ts + m.opts.ForGracePeriod
 				//
 				// Time remaining to fire = firingTime.Sub(ts)
 				//                        = (ts + m.opts.ForGracePeriod) - ts
 				//                        = m.opts.ForGracePeriod
-				restoredActiveAt = ts.Add(g.opts.ForGracePeriod).Add(-alertHoldDuration)
+				restoredActiveAt = ts.Add(g.opts.ForGracePeriod).Add(-holdDuration)
 			} else {
 				// By shifting ActiveAt to the future (ActiveAt + some_duration),
 				// the total pending time from the original ActiveAt
@@ -811,6 +843,10 @@ func (g *Group) Equals(ng *Group) bool {
 		return false
 	}
 
+	if g.dryRun != ng.dryRun {
+		return false
+	}
+
 	if len(g.rules) != len(ng.rules) {
 		return false
 	}
@@ -997,7 +1033,7 @@ func (m *Manager) LoadGroups(
 				}
 
 				if r.Alert.Value != "" {
-					rules = append(rules, NewAlertingRule(
+					alertingRule := NewAlertingRule(
 						r.Alert.Value,
 						expr,
 						time.Duration(r.For),
@@ -1006,7 +1042,9 @@ func (m *Manager) LoadGroups(
 						externalLabels,
 						m.restored,
 						log.With(m.logger, "alert", r.Alert),
-					))
+					)
+					alertingRule.SetForTemplate(r.ForTemplate)
+					rules = append(rules, alertingRule)
 					continue
 				}
 				rules = append(rules, NewRecordingRule(
@@ -1023,6 +1061,7 @@ func (m *Manager) LoadGroups(
 				Rules:         rules,
 				ShouldRestore: shouldRestore,
 				Opts:          m.opts,
+				DryRun:        rg.DryRun,
 				done:          m.done,
 			})
 		}
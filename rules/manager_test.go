@@ -581,6 +581,56 @@ func TestStaleness(t *testing.T) {
 	testutil.Equals(t, want, samples)
 }
 
+func TestDryRunGroup(t *testing.T) {
+	st := teststorage.New(t)
+	defer st.Close()
+	engineOpts := promql.EngineOpts{
+		Logger:     nil,
+		Reg:        nil,
+		MaxSamples: 10,
+		Timeout:    10 * time.Second,
+	}
+	engine := promql.NewEngine(engineOpts)
+	opts := &ManagerOptions{
+		QueryFunc:  EngineQueryFunc(engine, st),
+		Appendable: st,
+		Queryable:  st,
+		Context:    context.Background(),
+		Logger:     log.NewNopLogger(),
+	}
+
+	expr, err := parser.ParseExpr("a + 1")
+	testutil.Ok(t, err)
+	rule := NewRecordingRule("a_plus_one", expr, labels.Labels{})
+	group := NewGroup(GroupOptions{
+		Name:     "default",
+		Interval: time.Second,
+		Rules:    []Rule{rule},
+		Opts:     opts,
+		DryRun:   true,
+	})
+	testutil.Assert(t, group.DryRun(), "expected group to be a dry run")
+
+	app := st.Appender()
+	_, err = app.Add(labels.FromStrings(model.MetricNameLabel, "a"), 0, 1)
+	testutil.Ok(t, err)
+	testutil.Ok(t, app.Commit())
+
+	group.Eval(context.Background(), time.Unix(0, 0))
+
+	querier, err := st.Querier(context.Background(), 0, 2000)
+	testutil.Ok(t, err)
+	defer querier.Close()
+
+	matcher, err := labels.NewMatcher(labels.MatchEqual, model.MetricNameLabel, "a_plus_one")
+	testutil.Ok(t, err)
+
+	set := querier.Select(false, nil, matcher)
+	samples, err := readSeriesSet(set)
+	testutil.Ok(t, err)
+	testutil.Assert(t, len(samples) == 0, "dry-run group must not record any samples, got %v", samples)
+}
+
 // Convert a SeriesSet into a form usable with reflect.DeepEqual.
 func readSeriesSet(ss storage.SeriesSet) (map[string][]promql.Point, error) {
 	result := map[string][]promql.Point{}
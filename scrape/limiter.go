@@ -0,0 +1,93 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scrape
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// scrapesPerCPU is the number of concurrent scrapes allowed per available CPU
+// when no explicit global limit is configured. It only needs to be large
+// enough that a normal restart, where many targets can come due on their
+// first interval at roughly the same time, doesn't open thousands of
+// connections simultaneously.
+const scrapesPerCPU = 100
+
+var (
+	targetScrapesInFlight = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "prometheus_target_scrapes_in_flight",
+			Help: "Current number of scrapes being executed, across all scrape pools.",
+		},
+	)
+	targetScrapesQueued = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "prometheus_target_scrapes_queued",
+			Help: "Current number of scrapes waiting for a concurrency limit slot before they can start.",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(targetScrapesInFlight, targetScrapesQueued)
+}
+
+// scrapeLimiter bounds how many scrapes may be in flight at once. Callers
+// that lose the race to acquire a slot wait rather than fail outright, so a
+// burst of targets becoming due at the same time is smoothed out over time
+// instead of causing a spike of simultaneous outbound connections.
+type scrapeLimiter struct {
+	sem chan struct{}
+}
+
+// newScrapeLimiter returns a scrapeLimiter that allows up to n scrapes to run
+// concurrently. If n is 0 or negative, a GOMAXPROCS-derived default is used
+// instead, since a machine with more usable CPUs can typically also sustain
+// more concurrent HTTP clients and TLS handshakes.
+func newScrapeLimiter(n int) *scrapeLimiter {
+	if n <= 0 {
+		n = runtime.GOMAXPROCS(0) * scrapesPerCPU
+	}
+	return &scrapeLimiter{sem: make(chan struct{}, n)}
+}
+
+// acquire blocks until a slot is free or ctx is done, whichever comes first.
+func (l *scrapeLimiter) acquire(ctx context.Context) error {
+	select {
+	case l.sem <- struct{}{}:
+		targetScrapesInFlight.Inc()
+		return nil
+	default:
+	}
+
+	targetScrapesQueued.Inc()
+	defer targetScrapesQueued.Dec()
+
+	select {
+	case l.sem <- struct{}{}:
+		targetScrapesInFlight.Inc()
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release frees the slot acquired by a prior successful call to acquire.
+func (l *scrapeLimiter) release() {
+	<-l.sem
+	targetScrapesInFlight.Dec()
+}
@@ -0,0 +1,67 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scrape
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/util/testutil"
+)
+
+func TestScrapeLimiterDefaultsToGOMAXPROCS(t *testing.T) {
+	l := newScrapeLimiter(0)
+	testutil.Equals(t, runtime.GOMAXPROCS(0)*scrapesPerCPU, cap(l.sem))
+}
+
+func TestScrapeLimiterAcquireRelease(t *testing.T) {
+	l := newScrapeLimiter(1)
+
+	testutil.Ok(t, l.acquire(context.Background()))
+
+	// The single slot is taken, so a second acquire must block until release.
+	acquired := make(chan struct{})
+	go func() {
+		testutil.Ok(t, l.acquire(context.Background()))
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("second acquire returned before the slot was released")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	l.release()
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("second acquire did not unblock after release")
+	}
+	l.release()
+}
+
+func TestScrapeLimiterAcquireContextCanceled(t *testing.T) {
+	l := newScrapeLimiter(1)
+	testutil.Ok(t, l.acquire(context.Background()))
+	defer l.release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := l.acquire(ctx)
+	testutil.NotOk(t, err, "expected acquire to fail once its context timed out while queued")
+}
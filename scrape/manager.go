@@ -36,6 +36,10 @@ import (
 
 var targetMetadataCache = newMetadataMetricsCollector()
 
+// ErrScrapePoolNotFound is returned by PauseScrapePool and ResumeScrapePool
+// when no scrape pool with the given name currently exists.
+var ErrScrapePoolNotFound = errors.New("scrape pool not found")
+
 // MetadataMetricsCollector is a Custom Collector for the metadata cache metrics.
 type MetadataMetricsCollector struct {
 	CacheEntries *prometheus.Desc
@@ -100,8 +104,10 @@ func (mc *MetadataMetricsCollector) Collect(ch chan<- prometheus.Metric) {
 	}
 }
 
-// NewManager is the Manager constructor
-func NewManager(logger log.Logger, app storage.Appendable) *Manager {
+// NewManager is the Manager constructor. maxConcurrentScrapes caps the
+// number of scrapes allowed to be in flight at once across every scrape
+// pool this Manager owns; 0 picks a GOMAXPROCS-derived default.
+func NewManager(logger log.Logger, app storage.Appendable, maxConcurrentScrapes int) *Manager {
 	if logger == nil {
 		logger = log.NewNopLogger()
 	}
@@ -112,6 +118,7 @@ func NewManager(logger log.Logger, app storage.Appendable) *Manager {
 		scrapePools:   make(map[string]*scrapePool),
 		graceShut:     make(chan struct{}),
 		triggerReload: make(chan struct{}, 1),
+		scrapeLimiter: newScrapeLimiter(maxConcurrentScrapes),
 	}
 	targetMetadataCache.registerManager(m)
 
@@ -132,6 +139,10 @@ type Manager struct {
 	targetSets    map[string][]*targetgroup.Group
 
 	triggerReload chan struct{}
+
+	// scrapeLimiter bounds concurrent in-flight scrapes across all of this
+	// Manager's scrape pools, shared with each one of them.
+	scrapeLimiter *scrapeLimiter
 }
 
 // Run receives and saves target set updates and triggers the scraping loops reloading.
@@ -183,7 +194,7 @@ func (m *Manager) reload() {
 				level.Error(m.logger).Log("msg", "error reloading target set", "err", "invalid config id:"+setName)
 				continue
 			}
-			sp, err := newScrapePool(scrapeConfig, m.append, m.jitterSeed, log.With(m.logger, "scrape_pool", setName))
+			sp, err := newScrapePool(scrapeConfig, m.append, m.jitterSeed, log.With(m.logger, "scrape_pool", setName), m.scrapeLimiter)
 			if err != nil {
 				level.Error(m.logger).Log("msg", "error creating new scrape pool", "err", err, "scrape_pool", setName)
 				continue
@@ -320,6 +331,37 @@ func (m *Manager) TargetsDropped() map[string][]*Target {
 	return targets
 }
 
+// PauseScrapePool stops the named scrape pool from scraping its targets
+// until ResumeScrapePool is called or the job is removed from the config
+// entirely, letting operators stop hammering a broken backend without
+// editing the scrape config. The pause survives ApplyConfig reloads as
+// long as the pool itself keeps existing across them.
+func (m *Manager) PauseScrapePool(setName string) error {
+	m.mtxScrape.Lock()
+	defer m.mtxScrape.Unlock()
+
+	sp, ok := m.scrapePools[setName]
+	if !ok {
+		return ErrScrapePoolNotFound
+	}
+	sp.setPaused(true)
+	return nil
+}
+
+// ResumeScrapePool resumes scraping for the named scrape pool after a
+// prior PauseScrapePool call.
+func (m *Manager) ResumeScrapePool(setName string) error {
+	m.mtxScrape.Lock()
+	defer m.mtxScrape.Unlock()
+
+	sp, ok := m.scrapePools[setName]
+	if !ok {
+		return ErrScrapePoolNotFound
+	}
+	sp.setPaused(false)
+	return nil
+}
+
 // getFqdn returns a FQDN if it's possible, otherwise falls back to hostname.
 func getFqdn() (string, error) {
 	hostname, err := os.Hostname()
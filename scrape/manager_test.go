@@ -65,6 +65,35 @@ func TestPopulateLabels(t *testing.T) {
 				"custom":               "value",
 			}),
 		},
+		// SD metadata labels default __scheme__ and __metrics_path__ ahead of
+		// the scrape config's own values.
+		{
+			in: labels.FromMap(map[string]string{
+				model.AddressLabel: "1.2.3.4:1000",
+				model.MetaLabelPrefix + "kubernetes_pod_annotation_prometheus_io_scheme": "http",
+				model.MetaLabelPrefix + "kubernetes_pod_annotation_prometheus_io_path":   "/custom",
+			}),
+			cfg: &config.ScrapeConfig{
+				Scheme:      "https",
+				MetricsPath: "/metrics",
+				JobName:     "job",
+			},
+			res: labels.FromMap(map[string]string{
+				model.AddressLabel:     "1.2.3.4:1000",
+				model.InstanceLabel:    "1.2.3.4:1000",
+				model.SchemeLabel:      "http",
+				model.MetricsPathLabel: "/custom",
+				model.JobLabel:         "job",
+			}),
+			resOrig: labels.FromMap(map[string]string{
+				model.AddressLabel:     "1.2.3.4:1000",
+				model.SchemeLabel:      "http",
+				model.MetricsPathLabel: "/custom",
+				model.JobLabel:         "job",
+				model.MetaLabelPrefix + "kubernetes_pod_annotation_prometheus_io_scheme": "http",
+				model.MetaLabelPrefix + "kubernetes_pod_annotation_prometheus_io_path":   "/custom",
+			}),
+		},
 		// Pre-define/overwrite scrape config labels.
 		// Leave out port and expect it to be defaulted to scheme.
 		{
@@ -214,7 +243,7 @@ func TestPopulateLabels(t *testing.T) {
 	for _, c := range cases {
 		in := c.in.Copy()
 
-		res, orig, err := populateLabels(c.in, c.cfg)
+		res, orig, err := populateLabels(c.in, c.cfg, nil)
 		testutil.ErrorEqual(t, c.err, err)
 		testutil.Equals(t, c.in, in)
 		testutil.Equals(t, c.res, res)
@@ -273,7 +302,7 @@ scrape_configs:
 		ch = make(chan struct{}, 1)
 	)
 
-	scrapeManager := NewManager(nil, nil)
+	scrapeManager := NewManager(nil, nil, 0)
 	newLoop := func(scrapeLoopOptions) loop {
 		ch <- struct{}{}
 		return noopLoop()
@@ -334,8 +363,37 @@ scrape_configs:
 	}
 }
 
+func TestManagerPauseResumeScrapePool(t *testing.T) {
+	l := noopLoop().(*testLoop)
+	sp := &scrapePool{
+		activeTargets: map[uint64]*Target{},
+		loops:         map[uint64]loop{1: l},
+		client:        http.DefaultClient,
+	}
+
+	scrapeManager := NewManager(nil, nil, 0)
+	scrapeManager.scrapePools = map[string]*scrapePool{"job1": sp}
+
+	if err := scrapeManager.PauseScrapePool("job1"); err != nil {
+		t.Fatalf("unable to pause scrape pool: %s", err)
+	}
+	testutil.Assert(t, l.paused, "scrape pool was not paused")
+
+	if err := scrapeManager.ResumeScrapePool("job1"); err != nil {
+		t.Fatalf("unable to resume scrape pool: %s", err)
+	}
+	testutil.Assert(t, !l.paused, "scrape pool was not resumed")
+
+	if err := scrapeManager.PauseScrapePool("does-not-exist"); err != ErrScrapePoolNotFound {
+		t.Fatalf("expected ErrScrapePoolNotFound, got %v", err)
+	}
+	if err := scrapeManager.ResumeScrapePool("does-not-exist"); err != ErrScrapePoolNotFound {
+		t.Fatalf("expected ErrScrapePoolNotFound, got %v", err)
+	}
+}
+
 func TestManagerTargetsUpdates(t *testing.T) {
-	m := NewManager(nil, nil)
+	m := NewManager(nil, nil, 0)
 
 	ts := make(chan map[string][]*targetgroup.Group)
 	go m.Run(ts)
@@ -387,7 +445,7 @@ global:
 		return cfg
 	}
 
-	scrapeManager := NewManager(nil, nil)
+	scrapeManager := NewManager(nil, nil, 0)
 
 	// Load the first config.
 	cfg1 := getConfig("ha1")
@@ -25,11 +25,14 @@ import (
 	"net/http"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"time"
 	"unsafe"
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	config_util "github.com/prometheus/common/config"
@@ -171,6 +174,24 @@ type scrapePool struct {
 	loops          map[uint64]loop
 	cancel         context.CancelFunc
 
+	// paused is 1 if scraping for this pool is administratively paused, e.g.
+	// via the admin API, and 0 otherwise. It is applied to loops as they are
+	// (re)created by sync and reload, so it survives config reloads and
+	// target churn until explicitly cleared.
+	paused uint32
+
+	// relabelCache memoizes target relabeling across Sync calls, since most
+	// targets reappear unchanged from one discovery sync to the next. It is
+	// discarded and recreated whenever the relabel configs it was built for
+	// change, since a stale entry would otherwise apply.
+	relabelCache *relabel.Cache
+
+	// globalLimiter bounds in-flight scrapes across every scrape pool and is
+	// shared with all of them, while poolLimiter, if non-nil, additionally
+	// caps how many of this pool's own targets may be scraped at once.
+	globalLimiter *scrapeLimiter
+	poolLimiter   *scrapeLimiter
+
 	// Constructor for new scrape loops. This is settable for testing convenience.
 	newLoop func(scrapeLoopOptions) loop
 }
@@ -189,13 +210,13 @@ const maxAheadTime = 10 * time.Minute
 
 type labelsMutator func(labels.Labels) labels.Labels
 
-func newScrapePool(cfg *config.ScrapeConfig, app storage.Appendable, jitterSeed uint64, logger log.Logger) (*scrapePool, error) {
+func newScrapePool(cfg *config.ScrapeConfig, app storage.Appendable, jitterSeed uint64, logger log.Logger, globalLimiter *scrapeLimiter) (*scrapePool, error) {
 	targetScrapePools.Inc()
 	if logger == nil {
 		logger = log.NewNopLogger()
 	}
 
-	client, err := config_util.NewClientFromConfig(cfg.HTTPClientConfig, cfg.JobName, false)
+	client, err := config_util.NewClientFromConfig(cfg.HTTPClientConfig, cfg.JobName, cfg.DisableKeepAlives)
 	if err != nil {
 		targetScrapePoolsFailed.Inc()
 		return nil, errors.Wrap(err, "error creating HTTP client")
@@ -212,6 +233,14 @@ func newScrapePool(cfg *config.ScrapeConfig, app storage.Appendable, jitterSeed
 		activeTargets: map[uint64]*Target{},
 		loops:         map[uint64]loop{},
 		logger:        logger,
+		relabelCache:  relabel.NewCache(0),
+		globalLimiter: globalLimiter,
+	}
+	if cfg.ScrapeConcurrencyLimit > 0 {
+		sp.poolLimiter = newScrapeLimiter(int(cfg.ScrapeConcurrencyLimit))
+	}
+	if lifetime := time.Duration(cfg.MaxConnectionLifetime); lifetime > 0 {
+		go sp.closeIdleConnectionsPeriodically(ctx, lifetime)
 	}
 	sp.newLoop = func(opts scrapeLoopOptions) loop {
 		// Update the targets retrieval function for metadata to a new scrape cache.
@@ -234,6 +263,8 @@ func newScrapePool(cfg *config.ScrapeConfig, app storage.Appendable, jitterSeed
 			cache,
 			jitterSeed,
 			opts.honorTimestamps,
+			sp.globalLimiter,
+			sp.poolLimiter,
 		)
 	}
 
@@ -257,6 +288,27 @@ func (sp *scrapePool) DroppedTargets() []*Target {
 	return sp.droppedTargets
 }
 
+// setPaused pauses or resumes scraping for every target currently in the
+// pool and remembers the setting for loops started later by sync or
+// reload, so it stays in effect until a caller clears it again.
+func (sp *scrapePool) setPaused(paused bool) {
+	sp.mtx.Lock()
+	defer sp.mtx.Unlock()
+
+	if paused {
+		atomic.StoreUint32(&sp.paused, 1)
+	} else {
+		atomic.StoreUint32(&sp.paused, 0)
+	}
+	for _, l := range sp.loops {
+		l.setPaused(paused)
+	}
+}
+
+func (sp *scrapePool) isPaused() bool {
+	return atomic.LoadUint32(&sp.paused) == 1
+}
+
 // stop terminates all scrape loops and returns after they all terminated.
 func (sp *scrapePool) stop() {
 	sp.cancel()
@@ -280,6 +332,26 @@ func (sp *scrapePool) stop() {
 	sp.client.CloseIdleConnections()
 }
 
+// closeIdleConnectionsPeriodically closes idle HTTP connections held by the
+// pool's client every lifetime, forcing subsequent scrapes to dial a new
+// connection. This lets long-lived connections to a target behind a load
+// balancer get rebalanced over time instead of sticking to one backend.
+func (sp *scrapePool) closeIdleConnectionsPeriodically(ctx context.Context, lifetime time.Duration) {
+	ticker := time.NewTicker(lifetime)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sp.mtx.RLock()
+			client := sp.client
+			sp.mtx.RUnlock()
+			client.CloseIdleConnections()
+		}
+	}
+}
+
 // reload the scrape pool with the given scrape configuration. The target state is preserved
 // but all scrape loops are restarted with the new scrape configuration.
 // This method returns after all scrape loops that were stopped have stopped scraping.
@@ -290,13 +362,16 @@ func (sp *scrapePool) reload(cfg *config.ScrapeConfig) error {
 	sp.mtx.Lock()
 	defer sp.mtx.Unlock()
 
-	client, err := config_util.NewClientFromConfig(cfg.HTTPClientConfig, cfg.JobName, false)
+	client, err := config_util.NewClientFromConfig(cfg.HTTPClientConfig, cfg.JobName, cfg.DisableKeepAlives)
 	if err != nil {
 		targetScrapePoolReloadsFailed.Inc()
 		return errors.Wrap(err, "error creating HTTP client")
 	}
 
 	reuseCache := reusableCache(sp.config, cfg)
+	if sp.config == nil || !reflect.DeepEqual(sp.config.RelabelConfigs, cfg.RelabelConfigs) {
+		sp.relabelCache = relabel.NewCache(0)
+	}
 	sp.config = cfg
 	oldClient := sp.client
 	sp.client = client
@@ -332,6 +407,7 @@ func (sp *scrapePool) reload(cfg *config.ScrapeConfig) error {
 				cache:           cache,
 			})
 		)
+		newLoop.setPaused(sp.isPaused())
 		wg.Add(1)
 
 		go func(oldLoop, newLoop loop) {
@@ -361,7 +437,7 @@ func (sp *scrapePool) Sync(tgs []*targetgroup.Group) {
 	sp.mtx.Lock()
 	sp.droppedTargets = []*Target{}
 	for _, tg := range tgs {
-		targets, err := targetsFromGroup(tg, sp.config)
+		targets, err := targetsFromGroup(tg, sp.config, sp.relabelCache)
 		if err != nil {
 			level.Error(sp.logger).Log("msg", "creating targets failed", "err", err)
 			continue
@@ -416,6 +492,8 @@ func (sp *scrapePool) sync(targets []*Target) {
 				mrc:             mrc,
 			})
 
+			l.setPaused(sp.isPaused())
+
 			sp.activeTargets[hash] = t
 			sp.loops[hash] = l
 
@@ -547,8 +625,15 @@ func (s *targetScraper) scrape(ctx context.Context, w io.Writer) (string, error)
 		s.req = req
 	}
 
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Scrape")
+	defer span.Finish()
+
+	span.SetTag("target", s.URL().String())
+
 	resp, err := s.client.Do(s.req.WithContext(ctx))
 	if err != nil {
+		ext.Error.Set(span, true)
+		span.LogKV("error", err)
 		return "", err
 	}
 	defer func() {
@@ -556,8 +641,13 @@ func (s *targetScraper) scrape(ctx context.Context, w io.Writer) (string, error)
 		resp.Body.Close()
 	}()
 
+	span.SetTag("status_code", resp.StatusCode)
+
 	if resp.StatusCode != http.StatusOK {
-		return "", errors.Errorf("server returned HTTP status %s", resp.Status)
+		ext.Error.Set(span, true)
+		err := errors.Errorf("server returned HTTP status %s", resp.Status)
+		span.LogKV("error", err)
+		return "", err
 	}
 
 	if resp.Header.Get("Content-Encoding") != "gzip" {
@@ -595,6 +685,7 @@ type loop interface {
 	stop()
 	getCache() *scrapeCache
 	disableEndOfRunStalenessMarkers()
+	setPaused(paused bool)
 }
 
 type cacheEntry struct {
@@ -612,6 +703,14 @@ type scrapeLoop struct {
 	buffers         *pool.Pool
 	jitterSeed      uint64
 	honorTimestamps bool
+	paused          uint32
+
+	// startJitter is the delay applied once, before the first scrape of this
+	// loop's lifetime, to spread a pool's targets out across the interval.
+	// It is reported on every scrape as scrape_start_jitter_seconds so
+	// alerting can tell a thundering-herd pool (little spread) from a
+	// well-jittered one without digging through target offsets by hand.
+	startJitter time.Duration
 
 	appender            func() storage.Appender
 	sampleMutator       labelsMutator
@@ -623,6 +722,41 @@ type scrapeLoop struct {
 	stopped   chan struct{}
 
 	disabledEndOfRunStalenessMarkers bool
+
+	// globalLimiter and poolLimiter, if non-nil, must both have a free slot
+	// before a scrape is allowed to start; see acquireScrapeSlot.
+	globalLimiter *scrapeLimiter
+	poolLimiter   *scrapeLimiter
+}
+
+// acquireScrapeSlot blocks until every configured concurrency limit has a
+// free slot, or ctx is done, whichever happens first.
+func (sl *scrapeLoop) acquireScrapeSlot(ctx context.Context) error {
+	if sl.poolLimiter != nil {
+		if err := sl.poolLimiter.acquire(ctx); err != nil {
+			return err
+		}
+	}
+	if sl.globalLimiter != nil {
+		if err := sl.globalLimiter.acquire(ctx); err != nil {
+			if sl.poolLimiter != nil {
+				sl.poolLimiter.release()
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// releaseScrapeSlot frees the slots acquired by a prior successful call to
+// acquireScrapeSlot.
+func (sl *scrapeLoop) releaseScrapeSlot() {
+	if sl.globalLimiter != nil {
+		sl.globalLimiter.release()
+	}
+	if sl.poolLimiter != nil {
+		sl.poolLimiter.release()
+	}
 }
 
 // scrapeCache tracks mappings of exposed metric strings to label sets and
@@ -879,6 +1013,8 @@ func newScrapeLoop(ctx context.Context,
 	cache *scrapeCache,
 	jitterSeed uint64,
 	honorTimestamps bool,
+	globalLimiter *scrapeLimiter,
+	poolLimiter *scrapeLimiter,
 ) *scrapeLoop {
 	if l == nil {
 		l = log.NewNopLogger()
@@ -901,6 +1037,8 @@ func newScrapeLoop(ctx context.Context,
 		l:                   l,
 		parentCtx:           ctx,
 		honorTimestamps:     honorTimestamps,
+		globalLimiter:       globalLimiter,
+		poolLimiter:         poolLimiter,
 	}
 	sl.ctx, sl.cancel = context.WithCancel(ctx)
 
@@ -908,8 +1046,9 @@ func newScrapeLoop(ctx context.Context,
 }
 
 func (sl *scrapeLoop) run(interval, timeout time.Duration, errc chan<- error) {
+	sl.startJitter = sl.scraper.offset(interval, sl.jitterSeed)
 	select {
-	case <-time.After(sl.scraper.offset(interval, sl.jitterSeed)):
+	case <-time.After(sl.startJitter):
 		// Continue after a scraping offset.
 	case <-sl.ctx.Done():
 		close(sl.stopped)
@@ -932,6 +1071,18 @@ mainLoop:
 		default:
 		}
 
+		if atomic.LoadUint32(&sl.paused) == 1 {
+			select {
+			case <-sl.parentCtx.Done():
+				close(sl.stopped)
+				return
+			case <-sl.ctx.Done():
+				break mainLoop
+			case <-ticker.C:
+			}
+			continue
+		}
+
 		var (
 			start             = time.Now()
 			scrapeCtx, cancel = context.WithTimeout(sl.ctx, timeout)
@@ -947,7 +1098,16 @@ mainLoop:
 		b := sl.buffers.Get(sl.lastScrapeSize).([]byte)
 		buf := bytes.NewBuffer(b)
 
-		contentType, scrapeErr := sl.scraper.scrape(scrapeCtx, buf)
+		var contentType string
+		var scrapeErr error
+		if err := sl.acquireScrapeSlot(scrapeCtx); err != nil {
+			// The concurrency limit stayed full for the whole scrape timeout;
+			// treat it the same as a failed scrape so stale markers still fire.
+			scrapeErr = err
+		} else {
+			contentType, scrapeErr = sl.scraper.scrape(scrapeCtx, buf)
+			sl.releaseScrapeSlot()
+		}
 		cancel()
 
 		if scrapeErr == nil {
@@ -1068,6 +1228,17 @@ func (sl *scrapeLoop) getCache() *scrapeCache {
 	return sl.cache
 }
 
+// setPaused pauses or resumes the loop. A paused loop keeps ticking on its
+// configured interval but skips the scrape and append, so resuming it
+// doesn't cause a burst of catch-up scrapes.
+func (sl *scrapeLoop) setPaused(paused bool) {
+	if paused {
+		atomic.StoreUint32(&sl.paused, 1)
+	} else {
+		atomic.StoreUint32(&sl.paused, 0)
+	}
+}
+
 type appendErrors struct {
 	numOutOfOrder  int
 	numDuplicates  int
@@ -1273,6 +1444,7 @@ const (
 	scrapeSamplesMetricName      = "scrape_samples_scraped" + "\xff"
 	samplesPostRelabelMetricName = "scrape_samples_post_metric_relabeling" + "\xff"
 	scrapeSeriesAddedMetricName  = "scrape_series_added" + "\xff"
+	scrapeStartJitterMetricName  = "scrape_start_jitter_seconds" + "\xff"
 )
 
 func (sl *scrapeLoop) report(start time.Time, duration time.Duration, scraped, added, seriesAdded int, scrapeErr error) (err error) {
@@ -1308,6 +1480,9 @@ func (sl *scrapeLoop) report(start time.Time, duration time.Duration, scraped, a
 	if err = sl.addReportSample(app, scrapeSeriesAddedMetricName, ts, float64(seriesAdded)); err != nil {
 		return
 	}
+	if err = sl.addReportSample(app, scrapeStartJitterMetricName, ts, sl.startJitter.Seconds()); err != nil {
+		return
+	}
 	return
 }
 
@@ -1339,6 +1514,9 @@ func (sl *scrapeLoop) reportStale(start time.Time) (err error) {
 	if err = sl.addReportSample(app, scrapeSeriesAddedMetricName, ts, stale); err != nil {
 		return
 	}
+	if err = sl.addReportSample(app, scrapeStartJitterMetricName, ts, stale); err != nil {
+		return
+	}
 	return
 }
 
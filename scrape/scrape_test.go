@@ -50,7 +50,7 @@ func TestNewScrapePool(t *testing.T) {
 	var (
 		app   = &nopAppendable{}
 		cfg   = &config.ScrapeConfig{}
-		sp, _ = newScrapePool(cfg, app, 0, nil)
+		sp, _ = newScrapePool(cfg, app, 0, nil, nil)
 	)
 
 	if a, ok := sp.appendable.(*nopAppendable); !ok || a != app {
@@ -85,7 +85,7 @@ func TestDroppedTargetsList(t *testing.T) {
 				},
 			},
 		}
-		sp, _                  = newScrapePool(cfg, app, 0, nil)
+		sp, _                  = newScrapePool(cfg, app, 0, nil, nil)
 		expectedLabelSetString = "{__address__=\"127.0.0.1:9090\", job=\"dropMe\"}"
 		expectedLength         = 1
 	)
@@ -136,6 +136,7 @@ func TestDiscoveredLabelsUpdate(t *testing.T) {
 type testLoop struct {
 	startFunc func(interval, timeout time.Duration, errc chan<- error)
 	stopFunc  func()
+	paused    bool
 }
 
 func (l *testLoop) run(interval, timeout time.Duration, errc chan<- error) {
@@ -153,6 +154,10 @@ func (l *testLoop) getCache() *scrapeCache {
 	return nil
 }
 
+func (l *testLoop) setPaused(paused bool) {
+	l.paused = paused
+}
+
 func TestScrapePoolStop(t *testing.T) {
 	sp := &scrapePool{
 		activeTargets: map[uint64]*Target{},
@@ -211,6 +216,27 @@ func TestScrapePoolStop(t *testing.T) {
 	testutil.Assert(t, len(sp.loops) == 0, "Loops were not cleared on stopping: %d left", len(sp.loops))
 }
 
+func TestScrapePoolSetPaused(t *testing.T) {
+	sp := &scrapePool{
+		activeTargets: map[uint64]*Target{},
+		loops:         map[uint64]loop{},
+		client:        http.DefaultClient,
+	}
+
+	t1 := &Target{labels: labels.FromStrings(model.AddressLabel, "example.com:1")}
+	l1 := &testLoop{}
+	sp.activeTargets[t1.hash()] = t1
+	sp.loops[t1.hash()] = l1
+
+	sp.setPaused(true)
+	testutil.Assert(t, l1.paused, "existing loop was not paused")
+	testutil.Assert(t, sp.isPaused(), "pool did not record the paused setting")
+
+	sp.setPaused(false)
+	testutil.Assert(t, !l1.paused, "loop was not resumed")
+	testutil.Assert(t, !sp.isPaused(), "pool did not record the resumed setting")
+}
+
 func TestScrapePoolReload(t *testing.T) {
 	var mtx sync.Mutex
 	numTargets := 20
@@ -300,7 +326,7 @@ func TestScrapePoolReload(t *testing.T) {
 func TestScrapePoolAppender(t *testing.T) {
 	cfg := &config.ScrapeConfig{}
 	app := &nopAppendable{}
-	sp, _ := newScrapePool(cfg, app, 0, nil)
+	sp, _ := newScrapePool(cfg, app, 0, nil, nil)
 
 	loop := sp.newLoop(scrapeLoopOptions{
 		target: &Target{},
@@ -341,7 +367,7 @@ func TestScrapePoolRaces(t *testing.T) {
 	newConfig := func() *config.ScrapeConfig {
 		return &config.ScrapeConfig{ScrapeInterval: interval, ScrapeTimeout: timeout}
 	}
-	sp, _ := newScrapePool(newConfig(), &nopAppendable{}, 0, nil)
+	sp, _ := newScrapePool(newConfig(), &nopAppendable{}, 0, nil, nil)
 	tgts := []*targetgroup.Group{
 		{
 			Targets: []model.LabelSet{
@@ -382,6 +408,7 @@ func TestScrapeLoopStopBeforeRun(t *testing.T) {
 		nopMutator,
 		nil, nil, 0,
 		true,
+		nil, nil,
 	)
 
 	// The scrape pool synchronizes on stopping scrape loops. However, new scrape
@@ -446,6 +473,7 @@ func TestScrapeLoopStop(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 
 	// Terminate loop after 2 scrapes.
@@ -471,28 +499,79 @@ func TestScrapeLoopStop(t *testing.T) {
 		t.Fatalf("Scrape wasn't stopped.")
 	}
 
-	// We expected 1 actual sample for each scrape plus 5 for report samples.
+	// We expected 1 actual sample for each scrape plus 6 for report samples.
 	// At least 2 scrapes were made, plus the final stale markers.
-	if len(appender.result) < 6*3 || len(appender.result)%6 != 0 {
-		t.Fatalf("Expected at least 3 scrapes with 6 samples each, got %d samples", len(appender.result))
+	if len(appender.result) < 7*3 || len(appender.result)%7 != 0 {
+		t.Fatalf("Expected at least 3 scrapes with 7 samples each, got %d samples", len(appender.result))
 	}
 	// All samples in a scrape must have the same timestamp.
 	var ts int64
 	for i, s := range appender.result {
-		if i%6 == 0 {
+		if i%7 == 0 {
 			ts = s.t
 		} else if s.t != ts {
 			t.Fatalf("Unexpected multiple timestamps within single scrape")
 		}
 	}
 	// All samples from the last scrape must be stale markers.
-	for _, s := range appender.result[len(appender.result)-5:] {
+	for _, s := range appender.result[len(appender.result)-6:] {
 		if !value.IsStaleNaN(s.v) {
 			t.Fatalf("Appended last sample not as expected. Wanted: stale NaN Got: %x", math.Float64bits(s.v))
 		}
 	}
 }
 
+func TestScrapeLoopPause(t *testing.T) {
+	var (
+		signal   = make(chan struct{}, 1)
+		appender = &collectResultAppender{}
+		scraper  = &testScraper{}
+		app      = func() storage.Appender { return appender }
+	)
+
+	sl := newScrapeLoop(context.Background(),
+		scraper,
+		nil, nil,
+		nopMutator,
+		nopMutator,
+		app,
+		nil,
+		0,
+		true,
+		nil, nil,
+	)
+	sl.setPaused(true)
+
+	numScrapes := 0
+	scraper.scrapeFunc = func(ctx context.Context, w io.Writer) error {
+		numScrapes++
+		w.Write([]byte("metric_a 42\n"))
+		return nil
+	}
+
+	go func() {
+		sl.run(10*time.Millisecond, time.Hour, nil)
+		signal <- struct{}{}
+	}()
+
+	// Give the loop a few ticks to prove it isn't scraping while paused.
+	time.Sleep(50 * time.Millisecond)
+	testutil.Equals(t, 0, numScrapes)
+	testutil.Equals(t, 0, len(appender.result))
+
+	sl.setPaused(false)
+	for numScrapes == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	sl.stop()
+	select {
+	case <-signal:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Scrape wasn't stopped.")
+	}
+}
+
 func TestScrapeLoopRun(t *testing.T) {
 	var (
 		signal = make(chan struct{}, 1)
@@ -512,6 +591,7 @@ func TestScrapeLoopRun(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 
 	// The loop must terminate during the initial offset if the context
@@ -559,6 +639,7 @@ func TestScrapeLoopRun(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 
 	go func() {
@@ -609,6 +690,7 @@ func TestScrapeLoopMetadata(t *testing.T) {
 		cache,
 		0,
 		true,
+		nil, nil,
 	)
 	defer cancel()
 
@@ -658,6 +740,7 @@ func TestScrapeLoopSeriesAdded(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 	defer cancel()
 
@@ -692,6 +775,7 @@ func TestScrapeLoopRunCreatesStaleMarkersOnFailedScrape(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 	// Succeed once, several failures, then stop.
 	numScrapes := 0
@@ -719,12 +803,12 @@ func TestScrapeLoopRunCreatesStaleMarkersOnFailedScrape(t *testing.T) {
 		t.Fatalf("Scrape wasn't stopped.")
 	}
 
-	// 1 successfully scraped sample, 1 stale marker after first fail, 5 report samples for
+	// 1 successfully scraped sample, 1 stale marker after first fail, 6 report samples for
 	// each scrape successful or not.
-	testutil.Equals(t, 27, len(appender.result), "Appended samples not as expected")
+	testutil.Equals(t, 32, len(appender.result), "Appended samples not as expected")
 	testutil.Equals(t, 42.0, appender.result[0].v, "Appended first sample not as expected")
-	testutil.Assert(t, value.IsStaleNaN(appender.result[6].v),
-		"Appended second sample not as expected. Wanted: stale NaN Got: %x", math.Float64bits(appender.result[6].v))
+	testutil.Assert(t, value.IsStaleNaN(appender.result[7].v),
+		"Appended second sample not as expected. Wanted: stale NaN Got: %x", math.Float64bits(appender.result[7].v))
 }
 
 func TestScrapeLoopRunCreatesStaleMarkersOnParseFailure(t *testing.T) {
@@ -746,6 +830,7 @@ func TestScrapeLoopRunCreatesStaleMarkersOnParseFailure(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 
 	// Succeed once, several failures, then stop.
@@ -775,12 +860,12 @@ func TestScrapeLoopRunCreatesStaleMarkersOnParseFailure(t *testing.T) {
 		t.Fatalf("Scrape wasn't stopped.")
 	}
 
-	// 1 successfully scraped sample, 1 stale marker after first fail, 5 report samples for
+	// 1 successfully scraped sample, 1 stale marker after first fail, 6 report samples for
 	// each scrape successful or not.
-	testutil.Equals(t, 17, len(appender.result), "Appended samples not as expected")
+	testutil.Equals(t, 20, len(appender.result), "Appended samples not as expected")
 	testutil.Equals(t, 42.0, appender.result[0].v, "Appended first sample not as expected")
-	testutil.Assert(t, value.IsStaleNaN(appender.result[6].v),
-		"Appended second sample not as expected. Wanted: stale NaN Got: %x", math.Float64bits(appender.result[6].v))
+	testutil.Assert(t, value.IsStaleNaN(appender.result[7].v),
+		"Appended second sample not as expected. Wanted: stale NaN Got: %x", math.Float64bits(appender.result[7].v))
 }
 
 func TestScrapeLoopCache(t *testing.T) {
@@ -806,6 +891,7 @@ func TestScrapeLoopCache(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 
 	numScrapes := 0
@@ -852,10 +938,10 @@ func TestScrapeLoopCache(t *testing.T) {
 		t.Fatalf("Scrape wasn't stopped.")
 	}
 
-	// 1 successfully scraped sample, 1 stale marker after first fail, 5 report samples for
+	// 1 successfully scraped sample, 1 stale marker after first fail, 6 report samples for
 	// each scrape successful or not.
-	if len(appender.result) != 26 {
-		t.Fatalf("Appended samples not as expected. Wanted: %d samples Got: %d", 26, len(appender.result))
+	if len(appender.result) != 30 {
+		t.Fatalf("Appended samples not as expected. Wanted: %d samples Got: %d", 30, len(appender.result))
 	}
 }
 
@@ -882,6 +968,7 @@ func TestScrapeLoopCacheMemoryExhaustionProtection(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 
 	numScrapes := 0
@@ -988,6 +1075,7 @@ func TestScrapeLoopAppend(t *testing.T) {
 			nil,
 			0,
 			true,
+			nil, nil,
 		)
 
 		now := time.Now()
@@ -1027,6 +1115,7 @@ func TestScrapeLoopAppendCacheEntryButErrNotFound(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 
 	fakeRef := uint64(1)
@@ -1074,6 +1163,7 @@ func TestScrapeLoopAppendSampleLimit(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 
 	// Get the value of the Counter before performing the append.
@@ -1141,6 +1231,7 @@ func TestScrapeLoop_ChangingMetricString(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 
 	now := time.Now()
@@ -1177,6 +1268,7 @@ func TestScrapeLoopAppendStaleness(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 
 	now := time.Now()
@@ -1216,6 +1308,7 @@ func TestScrapeLoopAppendNoStalenessIfTimestamp(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 
 	now := time.Now()
@@ -1252,6 +1345,7 @@ func TestScrapeLoopRunReportsTargetDownOnScrapeError(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 
 	scraper.scrapeFunc = func(ctx context.Context, w io.Writer) error {
@@ -1280,6 +1374,7 @@ func TestScrapeLoopRunReportsTargetDownOnInvalidUTF8(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 
 	scraper.scrapeFunc = func(ctx context.Context, w io.Writer) error {
@@ -1325,6 +1420,7 @@ func TestScrapeLoopAppendGracefullyIfAmendOrOutOfOrderOrOutOfBounds(t *testing.T
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 
 	now := time.Unix(1, 0)
@@ -1360,6 +1456,7 @@ func TestScrapeLoopOutOfBoundsTimeError(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 
 	now := time.Now().Add(20 * time.Minute)
@@ -1545,6 +1642,7 @@ func TestScrapeLoop_RespectTimestamps(t *testing.T) {
 		func() storage.Appender { return capp },
 		nil, 0,
 		true,
+		nil, nil,
 	)
 
 	now := time.Now()
@@ -1576,6 +1674,7 @@ func TestScrapeLoop_DiscardTimestamps(t *testing.T) {
 		func() storage.Appender { return capp },
 		nil, 0,
 		false,
+		nil, nil,
 	)
 
 	now := time.Now()
@@ -1608,6 +1707,7 @@ func TestScrapeLoopDiscardDuplicateLabels(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 	defer cancel()
 
@@ -1654,6 +1754,7 @@ func TestScrapeLoopDiscardUnnamedMetrics(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 	defer cancel()
 
@@ -1744,7 +1845,7 @@ func TestReuseScrapeCache(t *testing.T) {
 			ScrapeInterval: model.Duration(5 * time.Second),
 			MetricsPath:    "/metrics",
 		}
-		sp, _ = newScrapePool(cfg, app, 0, nil)
+		sp, _ = newScrapePool(cfg, app, 0, nil, nil)
 		t1    = &Target{
 			discoveredLabels: labels.Labels{
 				labels.Label{
@@ -1870,6 +1971,7 @@ func TestScrapeAddFast(t *testing.T) {
 		nil,
 		0,
 		true,
+		nil, nil,
 	)
 	defer cancel()
 
@@ -1895,7 +1997,7 @@ func TestReuseCacheRace(t *testing.T) {
 			ScrapeInterval: model.Duration(5 * time.Second),
 			MetricsPath:    "/metrics",
 		}
-		sp, _ = newScrapePool(cfg, app, 0, nil)
+		sp, _ = newScrapePool(cfg, app, 0, nil, nil)
 		t1    = &Target{
 			discoveredLabels: labels.Labels{
 				labels.Label{
@@ -340,17 +340,58 @@ func (app *timeLimitAppender) AddFast(ref uint64, t int64, v float64) error {
 	return err
 }
 
+// schemeMetaLabels and metricsPathMetaLabels list the meta labels that
+// service discovery mechanisms already populate from the community's
+// de-facto "prometheus.io/scheme" / "prometheus.io/path" annotation and meta
+// field conventions (Kubernetes pod and service annotations, Consul service
+// metadata). When present, they take priority over the scrape config's own
+// scheme and metrics_path before relabeling runs, so configs no longer need
+// a relabel_configs block just to copy them across.
+var (
+	schemeMetaLabels = []string{
+		model.MetaLabelPrefix + "kubernetes_pod_annotation_prometheus_io_scheme",
+		model.MetaLabelPrefix + "kubernetes_service_annotation_prometheus_io_scheme",
+		model.MetaLabelPrefix + "consul_service_metadata_prometheus_scheme",
+	}
+	metricsPathMetaLabels = []string{
+		model.MetaLabelPrefix + "kubernetes_pod_annotation_prometheus_io_path",
+		model.MetaLabelPrefix + "kubernetes_service_annotation_prometheus_io_path",
+		model.MetaLabelPrefix + "consul_service_metadata_prometheus_path",
+	}
+)
+
+// firstMetaLabel returns the value of the first label in names that is set
+// on lset, or "" if none of them are.
+func firstMetaLabel(lset labels.Labels, names []string) string {
+	for _, n := range names {
+		if v := lset.Get(n); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// orDefault returns v if it is non-empty, otherwise def.
+func orDefault(v, def string) string {
+	if v != "" {
+		return v
+	}
+	return def
+}
+
 // populateLabels builds a label set from the given label set and scrape configuration.
 // It returns a label set before relabeling was applied as the second return value.
 // Returns the original discovered label set found before relabelling was applied if the target is dropped during relabeling.
-func populateLabels(lset labels.Labels, cfg *config.ScrapeConfig) (res, orig labels.Labels, err error) {
+// cache, if non-nil, is used to skip re-running cfg.RelabelConfigs against a
+// label set it has already seen.
+func populateLabels(lset labels.Labels, cfg *config.ScrapeConfig, cache *relabel.Cache) (res, orig labels.Labels, err error) {
 	// Copy labels into the labelset for the target if they are not set already.
 	scrapeLabels := []labels.Label{
 		{Name: model.JobLabel, Value: cfg.JobName},
-		{Name: model.MetricsPathLabel, Value: cfg.MetricsPath},
-		{Name: model.SchemeLabel, Value: cfg.Scheme},
+		{Name: model.MetricsPathLabel, Value: orDefault(firstMetaLabel(lset, metricsPathMetaLabels), cfg.MetricsPath)},
+		{Name: model.SchemeLabel, Value: orDefault(firstMetaLabel(lset, schemeMetaLabels), cfg.Scheme)},
 	}
-	lb := labels.NewBuilder(lset)
+	lb := labels.NewBuilderFromPool(lset)
 
 	for _, l := range scrapeLabels {
 		if lv := lset.Get(l.Name); lv == "" {
@@ -365,17 +406,24 @@ func populateLabels(lset labels.Labels, cfg *config.ScrapeConfig) (res, orig lab
 	}
 
 	preRelabelLabels := lb.Labels()
-	lset = relabel.Process(preRelabelLabels, cfg.RelabelConfigs...)
-
-	// Check if the target was dropped.
-	if lset == nil {
+	var keep bool
+	if cache != nil {
+		keep = cache.ProcessBuilder(lb, cfg.RelabelConfigs...)
+	} else {
+		keep = relabel.ProcessBuilder(lb, cfg.RelabelConfigs...)
+	}
+	if !keep {
+		labels.PutBuilder(lb)
 		return nil, preRelabelLabels, nil
 	}
+	lset = lb.Labels()
+	labels.PutBuilder(lb)
 	if v := lset.Get(model.AddressLabel); v == "" {
 		return nil, nil, errors.New("no address")
 	}
 
-	lb = labels.NewBuilder(lset)
+	lb = labels.NewBuilderFromPool(lset)
+	defer labels.PutBuilder(lb)
 
 	// addPort checks whether we should add a default port to the address.
 	// If the address is not valid, we don't append a port either.
@@ -432,7 +480,9 @@ func populateLabels(lset labels.Labels, cfg *config.ScrapeConfig) (res, orig lab
 }
 
 // targetsFromGroup builds targets based on the given TargetGroup and config.
-func targetsFromGroup(tg *targetgroup.Group, cfg *config.ScrapeConfig) ([]*Target, error) {
+// cache, if non-nil, is used to skip re-running cfg.RelabelConfigs against
+// label sets it has already relabeled.
+func targetsFromGroup(tg *targetgroup.Group, cfg *config.ScrapeConfig, cache *relabel.Cache) ([]*Target, error) {
 	targets := make([]*Target, 0, len(tg.Targets))
 
 	for i, tlset := range tg.Targets {
@@ -449,7 +499,7 @@ func targetsFromGroup(tg *targetgroup.Group, cfg *config.ScrapeConfig) ([]*Targe
 
 		lset := labels.New(lbls...)
 
-		lbls, origLabels, err := populateLabels(lset, cfg)
+		lbls, origLabels, err := populateLabels(lset, cfg, cache)
 		if err != nil {
 			return nil, errors.Wrapf(err, "instance %d in group %s", i, tg)
 		}
@@ -55,6 +55,43 @@ func NewFanout(logger log.Logger, primary Storage, secondaries ...Storage) Stora
 	}
 }
 
+// ReadConsistency selects which of a fanout Storage's underlying storages a
+// single query's Querier/ChunkQuerier should consult.
+type ReadConsistency string
+
+const (
+	// ReadConsistencyAll queries the primary and all secondary storages and
+	// merges their results. This is the default.
+	ReadConsistencyAll ReadConsistency = "all"
+	// ReadConsistencyLocal restricts a query to the primary storage only,
+	// skipping all secondaries.
+	ReadConsistencyLocal ReadConsistency = "local"
+	// ReadConsistencyRemote restricts a query to the secondary storages
+	// only, skipping the primary.
+	ReadConsistencyRemote ReadConsistency = "remote"
+)
+
+// readConsistencyContextKey is the context key under which
+// NewReadConsistencyContext stashes the requested ReadConsistency.
+type readConsistencyContextKey struct{}
+
+// NewReadConsistencyContext returns a new context that requests the given
+// read consistency from any fanout Storage whose Querier/ChunkQuerier is
+// created with it. It has no effect on storages that are not a fanout
+// Storage, such as a bare local TSDB.
+func NewReadConsistencyContext(ctx context.Context, c ReadConsistency) context.Context {
+	return context.WithValue(ctx, readConsistencyContextKey{}, c)
+}
+
+// readConsistencyFromContext returns the ReadConsistency requested by ctx,
+// defaulting to ReadConsistencyAll if none was set.
+func readConsistencyFromContext(ctx context.Context) ReadConsistency {
+	if c, ok := ctx.Value(readConsistencyContextKey{}).(ReadConsistency); ok {
+		return c
+	}
+	return ReadConsistencyAll
+}
+
 // StartTime implements the Storage interface.
 func (f *fanout) StartTime() (int64, error) {
 	// StartTime of a fanout should be the earliest StartTime of all its storages,
@@ -77,6 +114,28 @@ func (f *fanout) StartTime() (int64, error) {
 }
 
 func (f *fanout) Querier(ctx context.Context, mint, maxt int64) (Querier, error) {
+	switch readConsistencyFromContext(ctx) {
+	case ReadConsistencyLocal:
+		return f.primary.Querier(ctx, mint, maxt)
+	case ReadConsistencyRemote:
+		if len(f.secondaries) == 0 {
+			return NoopQuerier(), nil
+		}
+		secondaries := make([]Querier, 0, len(f.secondaries))
+		for _, storage := range f.secondaries {
+			querier, err := storage.Querier(ctx, mint, maxt)
+			if err != nil {
+				errs := tsdb_errors.MultiError{err}
+				for _, q := range secondaries {
+					errs.Add(q.Close())
+				}
+				return nil, errs.Err()
+			}
+			secondaries = append(secondaries, querier)
+		}
+		return NewMergeQuerier(nil, secondaries, ChainedSeriesMerge), nil
+	}
+
 	primary, err := f.primary.Querier(ctx, mint, maxt)
 	if err != nil {
 		return nil, err
@@ -100,6 +159,28 @@ func (f *fanout) Querier(ctx context.Context, mint, maxt int64) (Querier, error)
 }
 
 func (f *fanout) ChunkQuerier(ctx context.Context, mint, maxt int64) (ChunkQuerier, error) {
+	switch readConsistencyFromContext(ctx) {
+	case ReadConsistencyLocal:
+		return f.primary.ChunkQuerier(ctx, mint, maxt)
+	case ReadConsistencyRemote:
+		if len(f.secondaries) == 0 {
+			return NoopChunkedQuerier(), nil
+		}
+		secondaries := make([]ChunkQuerier, 0, len(f.secondaries))
+		for _, storage := range f.secondaries {
+			querier, err := storage.ChunkQuerier(ctx, mint, maxt)
+			if err != nil {
+				errs := tsdb_errors.MultiError{err}
+				for _, q := range secondaries {
+					errs.Add(q.Close())
+				}
+				return nil, errs.Err()
+			}
+			secondaries = append(secondaries, querier)
+		}
+		return NewMergeChunkQuerier(nil, secondaries, NewCompactingChunkSeriesMerger(ChainedSeriesMerge)), nil
+	}
+
 	primary, err := f.primary.ChunkQuerier(ctx, mint, maxt)
 	if err != nil {
 		return nil, err
@@ -207,6 +207,53 @@ func TestFanoutErrors(t *testing.T) {
 	}
 }
 
+func TestReadConsistency(t *testing.T) {
+	local := teststorage.New(t)
+	defer local.Close()
+	app := local.Appender()
+	_, err := app.Add(labels.FromStrings(model.MetricNameLabel, "local_only"), 0, 1)
+	testutil.Ok(t, err)
+	testutil.Ok(t, app.Commit())
+
+	remote := teststorage.New(t)
+	defer remote.Close()
+	app = remote.Appender()
+	_, err = app.Add(labels.FromStrings(model.MetricNameLabel, "remote_only"), 0, 2)
+	testutil.Ok(t, err)
+	testutil.Ok(t, app.Commit())
+
+	fanoutStorage := storage.NewFanout(nil, local, remote)
+
+	names := func(ctx context.Context) []string {
+		querier, err := fanoutStorage.Querier(ctx, 0, 1000)
+		testutil.Ok(t, err)
+		defer querier.Close()
+
+		names, _, err := querier.LabelValues(model.MetricNameLabel)
+		testutil.Ok(t, err)
+		return names
+	}
+
+	testutil.Equals(t, []string{"local_only", "remote_only"}, names(context.Background()))
+	testutil.Equals(t, []string{"local_only"}, names(storage.NewReadConsistencyContext(context.Background(), storage.ReadConsistencyLocal)))
+	testutil.Equals(t, []string{"remote_only"}, names(storage.NewReadConsistencyContext(context.Background(), storage.ReadConsistencyRemote)))
+	testutil.Equals(t, []string{"local_only", "remote_only"}, names(storage.NewReadConsistencyContext(context.Background(), storage.ReadConsistencyAll)))
+}
+
+func TestReadConsistencyRemoteWithNoSecondaries(t *testing.T) {
+	local := teststorage.New(t)
+	defer local.Close()
+
+	fanoutStorage := storage.NewFanout(nil, local)
+	querier, err := fanoutStorage.Querier(storage.NewReadConsistencyContext(context.Background(), storage.ReadConsistencyRemote), 0, 1000)
+	testutil.Ok(t, err)
+	defer querier.Close()
+
+	names, _, err := querier.LabelValues(model.MetricNameLabel)
+	testutil.Ok(t, err)
+	testutil.Equals(t, 0, len(names))
+}
+
 var errSelect = errors.New("select error")
 
 type errStorage struct{}
@@ -115,6 +115,13 @@ type SelectHints struct {
 	Grouping []string // List of label names used in aggregation.
 	By       bool     // Indicate whether it is without or by.
 	Range    int64    // Range vector selector range in milliseconds.
+
+	// SeriesLimit, if non-zero, caps the number of series this Select call
+	// is allowed to return. A Querier that enforces it is expected to abort
+	// early, once the limit is exceeded, rather than return a truncated
+	// result after doing all the work anyway. 0 means no call-specific
+	// limit; implementations that do not support limiting ignore it.
+	SeriesLimit int64
 }
 
 // TODO(bwplotka): Move to promql/engine_test.go?
@@ -144,14 +144,53 @@ type recoverableError struct {
 	error
 }
 
+// WriteResponseStats holds the counts a receiver reports back about a write
+// it accepted, parsed from the X-Prometheus-Remote-Write-*-Written response
+// headers. A receiver that predates these headers leaves Confirmed false and
+// all counts zero; callers should not treat that as proof of data loss.
+//
+// This fork's remote-write wire format only ever sends samples (no native
+// histograms or exemplars), so Histograms and Exemplars are parsed purely for
+// forward compatibility with receivers that send them and are otherwise
+// unused.
+type WriteResponseStats struct {
+	Confirmed  bool
+	Samples    int
+	Histograms int
+	Exemplars  int
+}
+
+func parseWriteResponseStats(resp *http.Response) WriteResponseStats {
+	var rs WriteResponseStats
+	if samples := resp.Header.Get("X-Prometheus-Remote-Write-Samples-Written"); samples != "" {
+		rs.Confirmed = true
+		if v, err := strconv.Atoi(samples); err == nil {
+			rs.Samples = v
+		}
+	}
+	if histograms := resp.Header.Get("X-Prometheus-Remote-Write-Histograms-Written"); histograms != "" {
+		rs.Confirmed = true
+		if v, err := strconv.Atoi(histograms); err == nil {
+			rs.Histograms = v
+		}
+	}
+	if exemplars := resp.Header.Get("X-Prometheus-Remote-Write-Exemplars-Written"); exemplars != "" {
+		rs.Confirmed = true
+		if v, err := strconv.Atoi(exemplars); err == nil {
+			rs.Exemplars = v
+		}
+	}
+	return rs
+}
+
 // Store sends a batch of samples to the HTTP endpoint, the request is the proto marshalled
 // and encoded bytes from codec.go.
-func (c *client) Store(ctx context.Context, req []byte) error {
+func (c *client) Store(ctx context.Context, req []byte) (WriteResponseStats, error) {
 	httpReq, err := http.NewRequest("POST", c.url.String(), bytes.NewReader(req))
 	if err != nil {
 		// Errors from NewRequest are from unparsable URLs, so are not
 		// recoverable.
-		return err
+		return WriteResponseStats{}, err
 	}
 	httpReq.Header.Add("Content-Encoding", "snappy")
 	httpReq.Header.Set("Content-Type", "application/x-protobuf")
@@ -177,7 +216,7 @@ func (c *client) Store(ctx context.Context, req []byte) error {
 	if err != nil {
 		// Errors from client.Do are from (for example) network errors, so are
 		// recoverable.
-		return recoverableError{err}
+		return WriteResponseStats{}, recoverableError{err}
 	}
 	defer func() {
 		io.Copy(ioutil.Discard, httpResp.Body)
@@ -191,11 +230,12 @@ func (c *client) Store(ctx context.Context, req []byte) error {
 			line = scanner.Text()
 		}
 		err = errors.Errorf("server returned HTTP status %s: %s", httpResp.Status, line)
+		if httpResp.StatusCode/100 == 5 {
+			return WriteResponseStats{}, recoverableError{err}
+		}
+		return WriteResponseStats{}, err
 	}
-	if httpResp.StatusCode/100 == 5 {
-		return recoverableError{err}
-	}
-	return err
+	return parseWriteResponseStats(httpResp), nil
 }
 
 // Name uniquely identifies the client.
@@ -73,9 +73,63 @@ func TestStoreHTTPErrorHandling(t *testing.T) {
 		c, err := NewWriteClient(hash, conf)
 		testutil.Ok(t, err)
 
-		err = c.Store(context.Background(), []byte{})
+		_, err = c.Store(context.Background(), []byte{})
 		testutil.ErrorEqual(t, err, test.err, "unexpected error in test %d", i)
 
 		server.Close()
 	}
 }
+
+func TestStoreConfirmedWritten(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Prometheus-Remote-Write-Samples-Written", "2")
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	testutil.Ok(t, err)
+
+	conf := &ClientConfig{
+		URL:     &config_util.URL{URL: serverURL},
+		Timeout: model.Duration(time.Second),
+	}
+
+	hash, err := toHash(conf)
+	testutil.Ok(t, err)
+	c, err := NewWriteClient(hash, conf)
+	testutil.Ok(t, err)
+
+	rs, err := c.Store(context.Background(), []byte{})
+	testutil.Ok(t, err)
+	testutil.Assert(t, rs.Confirmed, "expected the response stats to be confirmed")
+	testutil.Equals(t, 2, rs.Samples)
+}
+
+func TestStoreConfirmedWrittenMissingHeader(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	testutil.Ok(t, err)
+
+	conf := &ClientConfig{
+		URL:     &config_util.URL{URL: serverURL},
+		Timeout: model.Duration(time.Second),
+	}
+
+	hash, err := toHash(conf)
+	testutil.Ok(t, err)
+	c, err := NewWriteClient(hash, conf)
+	testutil.Ok(t, err)
+
+	rs, err := c.Store(context.Background(), []byte{})
+	testutil.Ok(t, err)
+	testutil.Assert(t, !rs.Confirmed, "expected the response stats to be unconfirmed without the header")
+}
@@ -106,9 +106,15 @@ func ToQuery(from, to int64, matchers []*labels.Matcher, hints *storage.SelectHi
 	}, nil
 }
 
-// ToQueryResult builds a QueryResult proto.
-func ToQueryResult(ss storage.SeriesSet, sampleLimit int) (*prompb.QueryResult, storage.Warnings, error) {
+// ToQueryResult builds a QueryResult proto. maxBytesInResponse, if non-zero,
+// caps the marshaled size of the result so that a query matching an
+// unexpectedly large number of series can't build an unbounded response in
+// memory; it is checked against the running total as each series is added,
+// so a single large result is rejected before the rest of the series are
+// even fetched.
+func ToQueryResult(ss storage.SeriesSet, sampleLimit, maxBytesInResponse int) (*prompb.QueryResult, storage.Warnings, error) {
 	numSamples := 0
+	responseBytes := 0
 	resp := &prompb.QueryResult{}
 	for ss.Next() {
 		series := ss.At()
@@ -133,10 +139,20 @@ func ToQueryResult(ss storage.SeriesSet, sampleLimit int) (*prompb.QueryResult,
 			return nil, ss.Warnings(), err
 		}
 
-		resp.Timeseries = append(resp.Timeseries, &prompb.TimeSeries{
-			Labels:  labelsToLabelsProto(series.Labels(), nil),
+		s := &prompb.TimeSeries{
+			Labels:  series.Labels().AppendToProto(nil),
 			Samples: samples,
-		})
+		}
+		if maxBytesInResponse > 0 {
+			responseBytes += s.Size()
+			if responseBytes > maxBytesInResponse {
+				return nil, ss.Warnings(), HTTPError{
+					msg:    fmt.Sprintf("exceeded bytes limit (%d) for the response", maxBytesInResponse),
+					status: http.StatusRequestEntityTooLarge,
+				}
+			}
+		}
+		resp.Timeseries = append(resp.Timeseries, s)
 	}
 	return resp, ss.Warnings(), ss.Err()
 }
@@ -145,7 +161,7 @@ func ToQueryResult(ss storage.SeriesSet, sampleLimit int) (*prompb.QueryResult,
 func FromQueryResult(sortSeries bool, res *prompb.QueryResult) storage.SeriesSet {
 	series := make([]storage.Series, 0, len(res.Timeseries))
 	for _, ts := range res.Timeseries {
-		lbls := labelProtosToLabels(ts.Labels)
+		lbls := labels.FromProtoLabels(ts.Labels)
 		if err := validateLabelsAndMetricName(lbls); err != nil {
 			return errSeriesSet{err: err}
 		}
@@ -198,7 +214,7 @@ func DeprecatedStreamChunkedReadResponses(
 	for ss.Next() {
 		series := ss.At()
 		iter := series.Iterator()
-		lbls = MergeLabels(labelsToLabelsProto(series.Labels(), lbls), sortedExternalLabels)
+		lbls = MergeLabels(series.Labels().AppendToProto(lbls), sortedExternalLabels)
 
 		lblsSize = 0
 		for _, lbl := range lbls {
@@ -324,7 +340,7 @@ func StreamChunkedReadResponses(
 	for ss.Next() {
 		series := ss.At()
 		iter := series.Iterator()
-		lbls = MergeLabels(labelsToLabelsProto(series.Labels(), lbls), sortedExternalLabels)
+		lbls = MergeLabels(series.Labels().AppendToProto(lbls), sortedExternalLabels)
 
 		frameBytesLeft := maxBytesInFrame
 		for _, lbl := range lbls {
@@ -582,31 +598,3 @@ func LabelProtosToMetric(labelPairs []*prompb.Label) model.Metric {
 	}
 	return metric
 }
-
-func labelProtosToLabels(labelPairs []prompb.Label) labels.Labels {
-	result := make(labels.Labels, 0, len(labelPairs))
-	for _, l := range labelPairs {
-		result = append(result, labels.Label{
-			Name:  l.Name,
-			Value: l.Value,
-		})
-	}
-	sort.Sort(result)
-	return result
-}
-
-// labelsToLabelsProto transforms labels into prompb labels. The buffer slice
-// will be used to avoid allocations if it is big enough to store the labels.
-func labelsToLabelsProto(labels labels.Labels, buf []prompb.Label) []prompb.Label {
-	result := buf[:0]
-	if cap(buf) < len(labels) {
-		result = make([]prompb.Label, 0, len(labels))
-	}
-	for _, l := range labels {
-		result = append(result, prompb.Label{
-			Name:  l.Name,
-			Value: l.Value,
-		})
-	}
-	return result
-}
@@ -27,10 +27,12 @@ import (
 	"github.com/golang/snappy"
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/ext"
+	"golang.org/x/time/rate"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/prometheus/config"
 	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/logging"
 	"github.com/prometheus/prometheus/pkg/relabel"
 	"github.com/prometheus/prometheus/prompb"
 	"github.com/prometheus/prometheus/tsdb/record"
@@ -45,6 +47,12 @@ const (
 
 	// Allow 30% too many shards before scaling down.
 	shardToleranceFraction = 0.3
+
+	// Number of example series logged per rejected batch, and how often we
+	// allow ourselves to log them, so that a receiver rejecting every batch
+	// (e.g. because of a misconfigured limit) does not flood the logs.
+	maxRejectedSeriesExamples = 3
+	rejectedSeriesLogInterval = rate.Limit(1.0 / 15) // once per 15s
 )
 
 type queueManagerMetrics struct {
@@ -64,6 +72,7 @@ type queueManagerMetrics struct {
 	minNumShards          prometheus.Gauge
 	desiredNumShards      prometheus.Gauge
 	bytesSent             prometheus.Counter
+	confirmedWrittenTotal prometheus.Counter
 }
 
 func newQueueManagerMetrics(r prometheus.Registerer, rn, e string) *queueManagerMetrics {
@@ -176,6 +185,13 @@ func newQueueManagerMetrics(r prometheus.Registerer, rn, e string) *queueManager
 		Help:        "The total number of bytes sent by the queue.",
 		ConstLabels: constLabels,
 	})
+	m.confirmedWrittenTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace:   namespace,
+		Subsystem:   subsystem,
+		Name:        "samples_confirmed_written_total",
+		Help:        "Total number of samples the receiver confirmed it durably wrote, read from the X-Prometheus-Remote-Write-Samples-Written response header. Zero for receivers that don't send it, so this should only be compared against succeeded_samples_total for receivers known to support it.",
+		ConstLabels: constLabels,
+	})
 
 	return m
 }
@@ -197,6 +213,7 @@ func (m *queueManagerMetrics) register() {
 			m.minNumShards,
 			m.desiredNumShards,
 			m.bytesSent,
+			m.confirmedWrittenTotal,
 		)
 	}
 }
@@ -217,14 +234,18 @@ func (m *queueManagerMetrics) unregister() {
 		m.reg.Unregister(m.minNumShards)
 		m.reg.Unregister(m.desiredNumShards)
 		m.reg.Unregister(m.bytesSent)
+		m.reg.Unregister(m.confirmedWrittenTotal)
 	}
 }
 
 // WriteClient defines an interface for sending a batch of samples to an
 // external timeseries database.
 type WriteClient interface {
-	// Store stores the given samples in the remote storage.
-	Store(context.Context, []byte) error
+	// Store stores the given samples in the remote storage. It returns
+	// WriteResponseStats parsed from the receiver's response, which is the
+	// zero value if the receiver didn't report how many samples it durably
+	// wrote.
+	Store(context.Context, []byte) (WriteResponseStats, error)
 	// Name uniquely identifies the remote storage.
 	Name() string
 	// Endpoint is the remote read or write endpoint for the storage client.
@@ -238,12 +259,13 @@ type QueueManager struct {
 	// https://golang.org/pkg/sync/atomic/#pkg-note-BUG
 	lastSendTimestamp int64
 
-	logger         log.Logger
-	flushDeadline  time.Duration
-	cfg            config.QueueConfig
-	externalLabels labels.Labels
-	relabelConfigs []*relabel.Config
-	watcher        *wal.Watcher
+	logger               log.Logger
+	rejectedSeriesLogger log.Logger
+	flushDeadline        time.Duration
+	cfg                  config.QueueConfig
+	externalLabels       labels.Labels
+	relabelConfigs       []*relabel.Config
+	watcher              *wal.Watcher
 
 	clientMtx   sync.RWMutex
 	storeClient WriteClient
@@ -284,12 +306,13 @@ func NewQueueManager(
 
 	logger = log.With(logger, remoteName, client.Name(), endpoint, client.Endpoint())
 	t := &QueueManager{
-		logger:         logger,
-		flushDeadline:  flushDeadline,
-		cfg:            cfg,
-		externalLabels: externalLabels,
-		relabelConfigs: relabelConfigs,
-		storeClient:    client,
+		logger:               logger,
+		rejectedSeriesLogger: logging.RateLimit(logger, rejectedSeriesLogInterval),
+		flushDeadline:        flushDeadline,
+		cfg:                  cfg,
+		externalLabels:       externalLabels,
+		relabelConfigs:       relabelConfigs,
+		storeClient:          client,
 
 		seriesLabels:         make(map[uint64]labels.Labels),
 		seriesSegmentIndexes: make(map[uint64]int),
@@ -404,6 +427,20 @@ func (t *QueueManager) StoreSeries(series []record.RefSeries, index int) {
 	t.seriesMtx.Lock()
 	defer t.seriesMtx.Unlock()
 	for _, s := range series {
+		// A series ref is immutable once written, so if we've already
+		// interned its labels -- most commonly because the watcher is
+		// replaying a checkpoint that re-covers segments we already read --
+		// there's nothing to redo beyond bumping its segment index. Skipping
+		// the relabel and intern work here is what makes catch-up after a
+		// long outage fast on instances with millions of series.
+		if _, ok := t.seriesLabels[s.Ref]; ok {
+			t.seriesSegmentIndexes[s.Ref] = index
+			continue
+		}
+		if _, ok := t.droppedSeries[s.Ref]; ok {
+			continue
+		}
+
 		ls := processExternalLabels(s.Labels, t.externalLabels)
 		lbls := relabel.Process(ls, t.relabelConfigs...)
 		if len(lbls) == 0 {
@@ -412,13 +449,6 @@ func (t *QueueManager) StoreSeries(series []record.RefSeries, index int) {
 		}
 		t.seriesSegmentIndexes[s.Ref] = index
 		internLabels(lbls)
-
-		// We should not ever be replacing a series labels in the map, but just
-		// in case we do we need to ensure we do not leak the replaced interned
-		// strings.
-		if orig, ok := t.seriesLabels[s.Ref]; ok {
-			releaseLabels(orig)
-		}
 		t.seriesLabels[s.Ref] = lbls
 	}
 }
@@ -455,6 +485,21 @@ func (t *QueueManager) client() WriteClient {
 	return t.storeClient
 }
 
+// logRejectedSeriesExamples logs a handful of the series from a batch the
+// remote endpoint rejected (e.g. a 400 with a limit-exceeded detail), so an
+// operator can tell which series are violating receiver-side limits without
+// having to reproduce the request. It is rate-limited since a receiver that
+// rejects every batch would otherwise flood the logs.
+func (t *QueueManager) logRejectedSeriesExamples(samples []prompb.TimeSeries, cause error) {
+	n := len(samples)
+	if n > maxRejectedSeriesExamples {
+		n = maxRejectedSeriesExamples
+	}
+	for _, ts := range samples[:n] {
+		level.Warn(t.rejectedSeriesLogger).Log("msg", "Example series rejected by remote write endpoint", "series", labels.FromProtoLabels(ts.Labels).String(), "err", cause)
+	}
+}
+
 func internLabels(lbls labels.Labels) {
 	for i, l := range lbls {
 		lbls[i].Name = interner.intern(l.Name)
@@ -809,7 +854,7 @@ func (s *shards) runShard(ctx context.Context, shardID int, queue chan sample) {
 			// Number of pending samples is limited by the fact that sendSamples (via sendSamplesWithBackoff)
 			// retries endlessly, so once we reach max samples, if we can never send to the endpoint we'll
 			// stop reading from the queue. This makes it safe to reference pendingSamples by index.
-			pendingSamples[nPending].Labels = labelsToLabelsProto(sample.labels, pendingSamples[nPending].Labels)
+			pendingSamples[nPending].Labels = sample.labels.AppendToProto(pendingSamples[nPending].Labels)
 			pendingSamples[nPending].Samples[0].Timestamp = sample.t
 			pendingSamples[nPending].Samples[0].Value = sample.v
 			nPending++
@@ -841,6 +886,7 @@ func (s *shards) sendSamples(ctx context.Context, samples []prompb.TimeSeries, b
 	if err != nil {
 		level.Error(s.qm.logger).Log("msg", "non-recoverable error", "count", len(samples), "err", err)
 		s.qm.metrics.failedSamplesTotal.Add(float64(len(samples)))
+		s.qm.logRejectedSeriesExamples(samples, err)
 	}
 
 	// These counters are used to calculate the dynamic sharding, and as such
@@ -879,7 +925,7 @@ func (s *shards) sendSamplesWithBackoff(ctx context.Context, samples []prompb.Ti
 		span.SetTag("remote_url", s.qm.storeClient.Endpoint())
 
 		begin := time.Now()
-		err := s.qm.client().Store(ctx, *buf)
+		rs, err := s.qm.client().Store(ctx, *buf)
 		s.qm.metrics.sentBatchDuration.Observe(time.Since(begin).Seconds())
 
 		if err != nil {
@@ -888,6 +934,10 @@ func (s *shards) sendSamplesWithBackoff(ctx context.Context, samples []prompb.Ti
 			return err
 		}
 
+		if rs.Confirmed {
+			s.qm.metrics.confirmedWrittenTotal.Add(float64(rs.Samples))
+		}
+
 		return nil
 	}
 
@@ -180,6 +180,38 @@ func TestShutdown(t *testing.T) {
 	}
 }
 
+type countingLogger struct {
+	n int
+}
+
+func (l *countingLogger) Log(keyvals ...interface{}) error {
+	l.n++
+	return nil
+}
+
+func TestLogRejectedSeriesExamples(t *testing.T) {
+	c := NewTestBlockedWriteClient()
+	dir, err := ioutil.TempDir("", "TestLogRejectedSeriesExamples")
+	testutil.Ok(t, err)
+	defer os.RemoveAll(dir)
+
+	metrics := newQueueManagerMetrics(nil, "", "")
+	m := NewQueueManager(metrics, nil, nil, nil, dir, newEWMARate(ewmaWeight, shardUpdateDuration), config.DefaultQueueConfig, nil, nil, c, defaultFlushDeadline)
+
+	var cl countingLogger
+	m.rejectedSeriesLogger = &cl
+
+	n := maxRejectedSeriesExamples + 5
+	samples := make([]prompb.TimeSeries, n)
+	for i := range samples {
+		samples[i] = prompb.TimeSeries{
+			Labels: []prompb.Label{{Name: "__name__", Value: fmt.Sprintf("metric_%d", i)}},
+		}
+	}
+	m.logRejectedSeriesExamples(samples, fmt.Errorf("400 Bad Request: label value too long"))
+	testutil.Equals(t, maxRejectedSeriesExamples, cl.n)
+}
+
 func TestSeriesReset(t *testing.T) {
 	c := NewTestBlockedWriteClient()
 	deadline := 5 * time.Second
@@ -204,6 +236,32 @@ func TestSeriesReset(t *testing.T) {
 	testutil.Equals(t, numSegments*numSeries/2, len(m.seriesLabels))
 }
 
+func TestStoreSeries_SkipsAlreadyKnownSeries(t *testing.T) {
+	c := NewTestBlockedWriteClient()
+	deadline := 5 * time.Second
+
+	dir, err := ioutil.TempDir("", "TestStoreSeries_SkipsAlreadyKnownSeries")
+	testutil.Ok(t, err)
+	defer os.RemoveAll(dir)
+
+	metrics := newQueueManagerMetrics(nil, "", "")
+	m := NewQueueManager(metrics, nil, nil, nil, dir, newEWMARate(ewmaWeight, shardUpdateDuration), config.DefaultQueueConfig, nil, nil, c, deadline)
+
+	series := []record.RefSeries{{Ref: 1, Labels: labels.Labels{{Name: "a", Value: "a"}}}}
+	m.StoreSeries(series, 0)
+	testutil.Equals(t, 1, len(m.seriesLabels))
+	testutil.Equals(t, 0, m.seriesSegmentIndexes[1])
+
+	stored := m.seriesLabels[1]
+
+	// A checkpoint replay re-announcing a ref we already interned should
+	// only bump its segment index, not redo the relabel/intern work.
+	m.StoreSeries(series, 3)
+	testutil.Equals(t, 1, len(m.seriesLabels))
+	testutil.Equals(t, 3, m.seriesSegmentIndexes[1])
+	testutil.Assert(t, &stored[0] == &m.seriesLabels[1][0], "expected the already-interned labels to be reused, not replaced")
+}
+
 func TestReshard(t *testing.T) {
 	size := 10 // Make bigger to find more races.
 	nSeries := 6
@@ -434,7 +492,7 @@ func (c *TestWriteClient) waitForExpectedSampleCount() {
 	c.wg.Wait()
 }
 
-func (c *TestWriteClient) Store(_ context.Context, req []byte) error {
+func (c *TestWriteClient) Store(_ context.Context, req []byte) (WriteResponseStats, error) {
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
 	// nil buffers are ok for snappy, ignore cast error.
@@ -444,18 +502,18 @@ func (c *TestWriteClient) Store(_ context.Context, req []byte) error {
 	reqBuf, err := snappy.Decode(c.buf, req)
 	c.buf = reqBuf
 	if err != nil {
-		return err
+		return WriteResponseStats{}, err
 	}
 
 	var reqProto prompb.WriteRequest
 	if err := proto.Unmarshal(reqBuf, &reqProto); err != nil {
-		return err
+		return WriteResponseStats{}, err
 	}
 
 	count := 0
 	for _, ts := range reqProto.Timeseries {
 		var seriesName string
-		labels := labelProtosToLabels(ts.Labels)
+		labels := labels.FromProtoLabels(ts.Labels)
 		for _, label := range labels {
 			if label.Name == "__name__" {
 				seriesName = label.Value
@@ -469,7 +527,7 @@ func (c *TestWriteClient) Store(_ context.Context, req []byte) error {
 	if c.withWaitGroup {
 		c.wg.Add(-count)
 	}
-	return nil
+	return WriteResponseStats{Confirmed: true, Samples: count}, nil
 }
 
 func (c *TestWriteClient) Name() string {
@@ -492,10 +550,10 @@ func NewTestBlockedWriteClient() *TestBlockingWriteClient {
 	return &TestBlockingWriteClient{}
 }
 
-func (c *TestBlockingWriteClient) Store(ctx context.Context, _ []byte) error {
+func (c *TestBlockingWriteClient) Store(ctx context.Context, _ []byte) (WriteResponseStats, error) {
 	atomic.AddUint64(&c.numCalls, 1)
 	<-ctx.Done()
-	return nil
+	return WriteResponseStats{}, nil
 }
 
 func (c *TestBlockingWriteClient) NumCalls() uint64 {
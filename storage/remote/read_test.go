@@ -188,12 +188,12 @@ func TestSeriesSetFilter(t *testing.T) {
 			toRemove: labels.Labels{{Name: "foo", Value: "bar"}},
 			in: &prompb.QueryResult{
 				Timeseries: []*prompb.TimeSeries{
-					{Labels: labelsToLabelsProto(labels.FromStrings("foo", "bar", "a", "b"), nil), Samples: []prompb.Sample{}},
+					{Labels: labels.FromStrings("foo", "bar", "a", "b").AppendToProto(nil), Samples: []prompb.Sample{}},
 				},
 			},
 			expected: &prompb.QueryResult{
 				Timeseries: []*prompb.TimeSeries{
-					{Labels: labelsToLabelsProto(labels.FromStrings("a", "b"), nil), Samples: []prompb.Sample{}},
+					{Labels: labels.FromStrings("a", "b").AppendToProto(nil), Samples: []prompb.Sample{}},
 				},
 			},
 		},
@@ -201,7 +201,7 @@ func TestSeriesSetFilter(t *testing.T) {
 
 	for _, tc := range tests {
 		filtered := newSeriesSetFilter(FromQueryResult(true, tc.in), tc.toRemove)
-		act, ws, err := ToQueryResult(filtered, 1e6)
+		act, ws, err := ToQueryResult(filtered, 1e6, 0)
 		testutil.Ok(t, err)
 		testutil.Equals(t, 0, len(ws))
 		testutil.Equals(t, tc.expected, act)
@@ -226,7 +226,7 @@ func (c *mockedRemoteClient) Read(_ context.Context, query *prompb.Query) (*prom
 
 	q := &prompb.QueryResult{}
 	for _, s := range c.store {
-		l := labelProtosToLabels(s.Labels)
+		l := labels.FromProtoLabels(s.Labels)
 		var notMatch bool
 
 		for _, m := range matchers {
@@ -40,6 +40,32 @@ var cfg = config.RemoteWriteConfig{
 	QueueConfig: config.DefaultQueueConfig,
 }
 
+// TestWriteRelabelConfigsRouteByLabel confirms that, since WriteRelabelConfigs
+// is evaluated independently per RemoteWriteConfig, a "keep" rule matching a
+// region-hint label already routes each series to only the remote_write
+// endpoints configured to accept its region -- no separate routing mechanism
+// is needed to split series across regional long-term stores.
+func TestWriteRelabelConfigsRouteByLabel(t *testing.T) {
+	keepRegion := func(region string) []*relabel.Config {
+		return []*relabel.Config{{
+			SourceLabels: model.LabelNames{"region"},
+			Regex:        relabel.MustNewRegexp(region),
+			Action:       relabel.Keep,
+		}}
+	}
+
+	usSeries := labels.Labels{{Name: "__name__", Value: "up"}, {Name: "region", Value: "us"}}
+	euSeries := labels.Labels{{Name: "__name__", Value: "up"}, {Name: "region", Value: "eu"}}
+
+	usRelabel := keepRegion("us")
+	euRelabel := keepRegion("eu")
+
+	testutil.Assert(t, relabel.Process(usSeries, usRelabel...) != nil, "us series should reach the us endpoint")
+	testutil.Assert(t, relabel.Process(euSeries, usRelabel...) == nil, "eu series should not reach the us endpoint")
+	testutil.Assert(t, relabel.Process(euSeries, euRelabel...) != nil, "eu series should reach the eu endpoint")
+	testutil.Assert(t, relabel.Process(usSeries, euRelabel...) == nil, "us series should not reach the eu endpoint")
+}
+
 func TestNoDuplicateWriteConfigs(t *testing.T) {
 	dir, err := ioutil.TempDir("", "TestNoDuplicateWriteConfigs")
 	testutil.Ok(t, err)
@@ -145,7 +145,28 @@ type BlockMeta struct {
 
 	// Version of the index format.
 	Version int `json:"version"`
-}
+
+	// Source of the block's samples, e.g. whether it came from a normal
+	// scrape-and-compact cycle, was backfilled, or is itself the result of
+	// compacting other blocks. Empty for blocks written before this field
+	// existed.
+	Source BlockMetaSource `json:"source,omitempty"`
+}
+
+// BlockMetaSource describes where a block's samples originally came from.
+type BlockMetaSource string
+
+const (
+	// SourceScrape marks a block written directly from the head, i.e. from
+	// normal scrape ingestion.
+	SourceScrape BlockMetaSource = "scrape"
+	// SourceBackfill marks a block created by CreateBlock, e.g. via
+	// "promtool tsdb create-blocks-from".
+	SourceBackfill BlockMetaSource = "backfill"
+	// SourceCompaction marks a block produced by compacting one or more
+	// existing blocks together.
+	SourceCompaction BlockMetaSource = "compaction"
+)
 
 // BlockStats contains stats about contents of a block.
 type BlockStats struct {
@@ -565,7 +586,7 @@ func (pb *Block) CleanTombstones(dest string, c Compactor) (*ulid.ULID, error) {
 	}
 
 	meta := pb.Meta()
-	uid, err := c.Write(dest, pb, pb.meta.MinTime, pb.meta.MaxTime, &meta)
+	uid, err := c.Write(dest, pb, pb.meta.MinTime, pb.meta.MaxTime, &meta, meta.Source)
 	if err != nil {
 		return nil, err
 	}
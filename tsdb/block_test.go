@@ -319,13 +319,13 @@ func createBlockFromHead(tb testing.TB, dir string, head *Head) string {
 
 	// Add +1 millisecond to block maxt because block intervals are half-open: [b.MinTime, b.MaxTime).
 	// Because of this block intervals are always +1 than the total samples it includes.
-	ulid, err := compactor.Write(dir, head, head.MinTime(), head.MaxTime()+1, nil)
+	ulid, err := compactor.Write(dir, head, head.MinTime(), head.MaxTime()+1, nil, SourceScrape)
 	testutil.Ok(tb, err)
 	return filepath.Join(dir, ulid.String())
 }
 
 func createHead(tb testing.TB, series []storage.Series, chunkDir string) *Head {
-	head, err := NewHead(nil, nil, nil, 2*60*60*1000, chunkDir, nil, DefaultStripeSize, nil)
+	head, err := NewHead(nil, nil, nil, 2*60*60*1000, chunkDir, nil, DefaultStripeSize, DefaultHeadChunkWriteQueueSize, nil)
 	testutil.Ok(tb, err)
 
 	app := head.Appender()
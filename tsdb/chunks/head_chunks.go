@@ -26,8 +26,10 @@ import (
 	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/prometheus/tsdb/chunkenc"
 	tsdb_errors "github.com/prometheus/prometheus/tsdb/errors"
 	"github.com/prometheus/prometheus/tsdb/fileutil"
@@ -40,6 +42,13 @@ const (
 
 	headChunksFormatV1 = 1
 	writeBufferSize    = 4 * 1024 * 1024 // 4 MiB.
+
+	// DefaultWriteQueueSize is the number of WriteChunk calls ChunkDiskMapper
+	// admits at once before additional callers have to wait for a slot to
+	// free up. It exists to turn an unbounded pile-up of goroutines blocked
+	// on writePathMtx during a burst of series creation into a bounded,
+	// observable queue instead.
+	DefaultWriteQueueSize = 1000
 )
 
 var (
@@ -115,6 +124,39 @@ type ChunkDiskMapper struct {
 	fileMaxtSet bool
 
 	closed bool
+
+	// writeQueue admits at most cap(writeQueue) concurrent WriteChunk
+	// callers; anyone past that waits here rather than piling up on
+	// writePathMtx, giving bursty series creation a bounded, observable
+	// queue instead of an unbounded stall.
+	writeQueue chan struct{}
+	metrics    *cdmMetrics
+}
+
+// cdmMetrics instruments ChunkDiskMapper's write queue so operators can see
+// how deep it gets and how long callers stall in it during a burst of new
+// series, rather than only noticing appends getting slower overall.
+type cdmMetrics struct {
+	writeQueueLength   prometheus.Gauge
+	writeStallDuration prometheus.Histogram
+}
+
+func newCDMMetrics(r prometheus.Registerer) *cdmMetrics {
+	m := &cdmMetrics{
+		writeQueueLength: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "prometheus_tsdb_head_chunk_write_queue_length",
+			Help: "Number of WriteChunk calls currently admitted to or waiting for ChunkDiskMapper's write queue.",
+		}),
+		writeStallDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "prometheus_tsdb_head_chunk_write_stall_duration_seconds",
+			Help:    "Time a WriteChunk call spent waiting for a free slot in the write queue before it could proceed.",
+			Buckets: prometheus.ExponentialBuckets(0.0001, 4, 10),
+		}),
+	}
+	if r != nil {
+		r.MustRegister(m.writeQueueLength, m.writeStallDuration)
+	}
+	return m
 }
 
 type mmappedChunkFile struct {
@@ -123,10 +165,18 @@ type mmappedChunkFile struct {
 }
 
 // NewChunkDiskMapper returns a new writer against the given directory
-// using the default head chunk file duration.
+// using the default head chunk file duration and write queue size.
 // NOTE: 'IterateAllChunks' method needs to be called at least once after creating ChunkDiskMapper
 // to set the maxt of all the file.
-func NewChunkDiskMapper(dir string, pool chunkenc.Pool) (*ChunkDiskMapper, error) {
+func NewChunkDiskMapper(dir string, pool chunkenc.Pool, reg prometheus.Registerer) (*ChunkDiskMapper, error) {
+	return NewChunkDiskMapperWithQueueSize(dir, pool, reg, DefaultWriteQueueSize)
+}
+
+// NewChunkDiskMapperWithQueueSize is like NewChunkDiskMapper but lets the
+// caller size the write queue explicitly, e.g. to raise it on a system with
+// many concurrently appending scrape targets and fast storage, where the
+// default admits fewer concurrent writers than the workload can sustain.
+func NewChunkDiskMapperWithQueueSize(dir string, pool chunkenc.Pool, reg prometheus.Registerer, writeQueueSize int) (*ChunkDiskMapper, error) {
 	if err := os.MkdirAll(dir, 0777); err != nil {
 		return nil, err
 	}
@@ -140,6 +190,8 @@ func NewChunkDiskMapper(dir string, pool chunkenc.Pool) (*ChunkDiskMapper, error
 		pool:        pool,
 		crc32:       newCRC32(),
 		chunkBuffer: newChunkBuffer(),
+		writeQueue:  make(chan struct{}, writeQueueSize),
+		metrics:     newCDMMetrics(reg),
 	}
 
 	if m.pool == nil {
@@ -234,6 +286,15 @@ func listChunkFiles(dir string) (map[int]string, error) {
 // WriteChunk writes the chunk to the disk.
 // The returned chunk ref is the reference from where the chunk encoding starts for the chunk.
 func (cdm *ChunkDiskMapper) WriteChunk(seriesRef uint64, mint, maxt int64, chk chunkenc.Chunk) (chkRef uint64, err error) {
+	stallStart := time.Now()
+	cdm.writeQueue <- struct{}{}
+	cdm.metrics.writeStallDuration.Observe(time.Since(stallStart).Seconds())
+	cdm.metrics.writeQueueLength.Set(float64(len(cdm.writeQueue)))
+	defer func() {
+		<-cdm.writeQueue
+		cdm.metrics.writeQueueLength.Set(float64(len(cdm.writeQueue)))
+	}()
+
 	cdm.writePathMtx.Lock()
 	defer cdm.writePathMtx.Unlock()
 
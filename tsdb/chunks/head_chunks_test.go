@@ -19,6 +19,7 @@ import (
 	"math/rand"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/prometheus/prometheus/tsdb/chunkenc"
 	"github.com/prometheus/prometheus/util/testutil"
@@ -130,7 +131,7 @@ func TestHeadReadWriter_WriteChunk_Chunk_IterateChunks(t *testing.T) {
 	// Testing IterateAllChunks method.
 	dir := hrw.dir.Name()
 	testutil.Ok(t, hrw.Close())
-	hrw, err = NewChunkDiskMapper(dir, chunkenc.NewPool())
+	hrw, err = NewChunkDiskMapper(dir, chunkenc.NewPool(), nil)
 	testutil.Ok(t, err)
 
 	idx := 0
@@ -239,7 +240,7 @@ func TestHeadReadWriter_Truncate(t *testing.T) {
 
 	// Restarted.
 	var err error
-	hrw, err = NewChunkDiskMapper(dir, chunkenc.NewPool())
+	hrw, err = NewChunkDiskMapper(dir, chunkenc.NewPool(), nil)
 	testutil.Ok(t, err)
 
 	testutil.Assert(t, !hrw.fileMaxtSet, "")
@@ -332,15 +333,52 @@ func TestHeadReadWriter_Truncate_NoUnsequentialFiles(t *testing.T) {
 
 	// Restarting checks for unsequential files.
 	var err error
-	hrw, err = NewChunkDiskMapper(dir, chunkenc.NewPool())
+	hrw, err = NewChunkDiskMapper(dir, chunkenc.NewPool(), nil)
 	testutil.Ok(t, err)
 	verifyFiles([]int{3, 4, 5, 6, 7})
 }
 
+func TestChunkDiskMapper_WriteQueueBackpressure(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "data")
+	testutil.Ok(t, err)
+	defer func() {
+		testutil.Ok(t, os.RemoveAll(tmpdir))
+	}()
+
+	hrw, err := NewChunkDiskMapperWithQueueSize(tmpdir, chunkenc.NewPool(), nil, 1)
+	testutil.Ok(t, err)
+	defer func() {
+		testutil.Ok(t, hrw.Close())
+	}()
+
+	// Hold the only queue slot so a concurrent WriteChunk has to wait for it.
+	hrw.writeQueue <- struct{}{}
+	testutil.Equals(t, 1, len(hrw.writeQueue))
+
+	done := make(chan struct{})
+	go func() {
+		createChunk(t, 0, hrw)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("WriteChunk returned before a queue slot was freed")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	<-hrw.writeQueue
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("WriteChunk did not proceed after a queue slot freed up")
+	}
+}
+
 func testHeadReadWriter(t *testing.T) (hrw *ChunkDiskMapper, close func()) {
 	tmpdir, err := ioutil.TempDir("", "data")
 	testutil.Ok(t, err)
-	hrw, err = NewChunkDiskMapper(tmpdir, chunkenc.NewPool())
+	hrw, err = NewChunkDiskMapper(tmpdir, chunkenc.NewPool(), nil)
 	testutil.Ok(t, err)
 	testutil.Assert(t, !hrw.fileMaxtSet, "")
 	testutil.Ok(t, hrw.IterateAllChunks(func(_, _ uint64, _, _ int64, _ uint16) error { return nil }))
@@ -59,9 +59,10 @@ type Compactor interface {
 	// Results returned when compactions are in progress are undefined.
 	Plan(dir string) ([]string, error)
 
-	// Write persists a Block into a directory.
+	// Write persists a Block into a directory, recording source as its
+	// BlockMeta.Source.
 	// No Block is written when resulting Block has 0 samples, and returns empty ulid.ULID{}.
-	Write(dest string, b BlockReader, mint, maxt int64, parent *BlockMeta) (ulid.ULID, error)
+	Write(dest string, b BlockReader, mint, maxt int64, parent *BlockMeta, source BlockMetaSource) (ulid.ULID, error)
 
 	// Compact runs compaction against the provided directories. Must
 	// only be called concurrently with results of Plan().
@@ -334,6 +335,7 @@ func compactBlockMetas(uid ulid.ULID, blocks ...*BlockMeta) *BlockMeta {
 	res := &BlockMeta{
 		ULID:    uid,
 		MinTime: blocks[0].MinTime,
+		Source:  SourceCompaction,
 	}
 
 	sources := map[ulid.ULID]struct{}{}
@@ -464,7 +466,7 @@ func (c *LeveledCompactor) Compact(dest string, dirs []string, open []*Block) (u
 	return uid, merr
 }
 
-func (c *LeveledCompactor) Write(dest string, b BlockReader, mint, maxt int64, parent *BlockMeta) (ulid.ULID, error) {
+func (c *LeveledCompactor) Write(dest string, b BlockReader, mint, maxt int64, parent *BlockMeta, source BlockMetaSource) (ulid.ULID, error) {
 	start := time.Now()
 
 	uid := ulid.MustNew(ulid.Now(), rand.Reader)
@@ -473,6 +475,7 @@ func (c *LeveledCompactor) Write(dest string, b BlockReader, mint, maxt int64, p
 		ULID:    uid,
 		MinTime: mint,
 		MaxTime: maxt,
+		Source:  source,
 	}
 	meta.Compaction.Level = 1
 	meta.Compaction.Sources = []ulid.ULID{uid}
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/go-kit/kit/log"
+	"github.com/oklog/ulid"
 	"github.com/pkg/errors"
 	prom_testutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/prometheus/prometheus/pkg/labels"
@@ -162,6 +163,15 @@ func TestNoPanicFor0Tombstones(t *testing.T) {
 	c.plan(metas)
 }
 
+func TestCompactBlockMetas_Source(t *testing.T) {
+	uid := ulid.MustNew(1, nil)
+	meta := compactBlockMetas(uid,
+		&BlockMeta{MinTime: 0, MaxTime: 100, Source: SourceScrape},
+		&BlockMeta{MinTime: 100, MaxTime: 200, Source: SourceBackfill},
+	)
+	testutil.Equals(t, SourceCompaction, meta.Source)
+}
+
 func TestLeveledCompactor_plan(t *testing.T) {
 	// This mimics our default ExponentialBlockRanges with min block size equals to 20.
 	compactor, err := NewLeveledCompactor(context.Background(), nil, nil, []int64{
@@ -875,7 +885,7 @@ func BenchmarkCompactionFromHead(b *testing.B) {
 			defer func() {
 				testutil.Ok(b, os.RemoveAll(chunkDir))
 			}()
-			h, err := NewHead(nil, nil, nil, 1000, chunkDir, nil, DefaultStripeSize, nil)
+			h, err := NewHead(nil, nil, nil, 1000, chunkDir, nil, DefaultStripeSize, DefaultHeadChunkWriteQueueSize, nil)
 			testutil.Ok(b, err)
 			for ln := 0; ln < labelNames; ln++ {
 				app := h.Appender()
@@ -60,14 +60,15 @@ var (
 // millisecond precision timestamps.
 func DefaultOptions() *Options {
 	return &Options{
-		WALSegmentSize:         wal.DefaultSegmentSize,
-		RetentionDuration:      int64(15 * 24 * time.Hour / time.Millisecond),
-		MinBlockDuration:       DefaultBlockDuration,
-		MaxBlockDuration:       DefaultBlockDuration,
-		NoLockfile:             false,
-		AllowOverlappingBlocks: false,
-		WALCompression:         false,
-		StripeSize:             DefaultStripeSize,
+		WALSegmentSize:          wal.DefaultSegmentSize,
+		RetentionDuration:       int64(15 * 24 * time.Hour / time.Millisecond),
+		MinBlockDuration:        DefaultBlockDuration,
+		MaxBlockDuration:        DefaultBlockDuration,
+		NoLockfile:              false,
+		AllowOverlappingBlocks:  false,
+		WALCompression:          false,
+		StripeSize:              DefaultStripeSize,
+		HeadChunkWriteQueueSize: DefaultHeadChunkWriteQueueSize,
 	}
 }
 
@@ -118,6 +119,39 @@ type Options struct {
 	// SeriesLifecycleCallback specifies a list of callbacks that will be called during a lifecycle of a series.
 	// It is always a no-op in Prometheus and mainly meant for external users who import TSDB.
 	SeriesLifecycleCallback SeriesLifecycleCallback
+
+	// AllowPartialBlockQueries, if true, makes Querier skip a block that
+	// fails to open a querier (e.g. a corrupt on-disk block) and surface it
+	// as a warning instead of failing the whole query. This favors a
+	// degraded-but-useful result, e.g. for dashboards during an incident,
+	// over a hard failure when only some of the queried blocks are healthy.
+	AllowPartialBlockQueries bool
+
+	// MaxSeriesPerQuery caps the number of series a single Select call is
+	// allowed to touch across all queried blocks. 0 or less means disabled.
+	// Queriers enforce it while expanding postings, before any chunk is
+	// decoded, and abort with ErrTooManySeries once it is exceeded. A
+	// caller can additionally lower this limit for a single call via
+	// storage.SelectHints.SeriesLimit.
+	MaxSeriesPerQuery int64
+
+	// BlockWarmupBytesPerSecond, if greater than 0, makes Open start a
+	// background goroutine that reads through the index and chunk files of
+	// the most recent blocks, at roughly this many bytes per second, so
+	// that the first queries after a restart aren't served from a cold
+	// page cache. 0 or less disables warm-up.
+	BlockWarmupBytesPerSecond int64
+
+	// BlockWarmupBlocks caps the number of most-recent blocks warmupBlocks
+	// reads through. 0 or less means no limit (all blocks are warmed).
+	BlockWarmupBlocks int
+
+	// HeadChunkWriteQueueSize is the size of the head chunk disk mapper's
+	// write queue: the number of WriteChunk calls it admits at once before
+	// additional callers have to wait for a slot to free up, bounding how
+	// many goroutines can pile up behind it during a burst of series
+	// creation. 0 or less uses DefaultHeadChunkWriteQueueSize.
+	HeadChunkWriteQueueSize int
 }
 
 // DB handles reads and writes of time series falling into
@@ -136,12 +170,21 @@ type DB struct {
 	mtx    sync.RWMutex
 	blocks []*Block
 
+	// blockMetaFilter, when set, excludes blocks for which it returns true
+	// from future Querier calls. See SetBlockMetaFilter.
+	blockMetaFilter BlockMetaFilter
+
 	head *Head
 
 	compactc chan struct{}
 	donec    chan struct{}
 	stopc    chan struct{}
 
+	// warmupWG is done once the background warmupBlocks goroutine, if any
+	// was started, has returned. Close waits on it so that a block warm-up
+	// never outlives the DB it was reading from.
+	warmupWG sync.WaitGroup
+
 	// cmtx ensures that compactions and deletions don't run simultaneously.
 	cmtx sync.Mutex
 
@@ -313,7 +356,7 @@ func (db *DBReadOnly) FlushWAL(dir string) (returnErr error) {
 	if err != nil {
 		return err
 	}
-	head, err := NewHead(nil, db.logger, w, 1, db.dir, nil, DefaultStripeSize, nil)
+	head, err := NewHead(nil, db.logger, w, 1, db.dir, nil, DefaultStripeSize, DefaultHeadChunkWriteQueueSize, nil)
 	if err != nil {
 		return err
 	}
@@ -347,7 +390,7 @@ func (db *DBReadOnly) FlushWAL(dir string) (returnErr error) {
 	}
 	// Add +1 millisecond to block maxt because block intervals are half-open: [b.MinTime, b.MaxTime).
 	// Because of this block intervals are always +1 than the total samples it includes.
-	_, err = compactor.Write(dir, rh, mint, maxt+1, nil)
+	_, err = compactor.Write(dir, rh, mint, maxt+1, nil, SourceScrape)
 	return errors.Wrap(err, "writing WAL")
 }
 
@@ -372,7 +415,7 @@ func (db *DBReadOnly) Querier(ctx context.Context, mint, maxt int64) (storage.Qu
 		blocks[i] = b
 	}
 
-	head, err := NewHead(nil, db.logger, nil, 1, db.dir, nil, DefaultStripeSize, nil)
+	head, err := NewHead(nil, db.logger, nil, 1, db.dir, nil, DefaultStripeSize, DefaultHeadChunkWriteQueueSize, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -390,7 +433,7 @@ func (db *DBReadOnly) Querier(ctx context.Context, mint, maxt int64) (storage.Qu
 		if err != nil {
 			return nil, err
 		}
-		head, err = NewHead(nil, db.logger, w, 1, db.dir, nil, DefaultStripeSize, nil)
+		head, err = NewHead(nil, db.logger, w, 1, db.dir, nil, DefaultStripeSize, DefaultHeadChunkWriteQueueSize, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -519,6 +562,9 @@ func validateOpts(opts *Options, rngs []int64) (*Options, []int64) {
 	if opts.StripeSize <= 0 {
 		opts.StripeSize = DefaultStripeSize
 	}
+	if opts.HeadChunkWriteQueueSize <= 0 {
+		opts.HeadChunkWriteQueueSize = DefaultHeadChunkWriteQueueSize
+	}
 
 	if opts.MinBlockDuration <= 0 {
 		opts.MinBlockDuration = DefaultBlockDuration
@@ -612,7 +658,7 @@ func open(dir string, l log.Logger, r prometheus.Registerer, opts *Options, rngs
 		}
 	}
 
-	db.head, err = NewHead(r, l, wlog, rngs[0], dir, db.chunkPool, opts.StripeSize, opts.SeriesLifecycleCallback)
+	db.head, err = NewHead(r, l, wlog, rngs[0], dir, db.chunkPool, opts.StripeSize, opts.HeadChunkWriteQueueSize, opts.SeriesLifecycleCallback)
 	if err != nil {
 		return nil, err
 	}
@@ -636,6 +682,11 @@ func open(dir string, l log.Logger, r prometheus.Registerer, opts *Options, rngs
 		}
 	}
 
+	if opts.BlockWarmupBytesPerSecond > 0 {
+		db.warmupWG.Add(1)
+		go db.warmupBlocks(opts.BlockWarmupBytesPerSecond, opts.BlockWarmupBlocks)
+	}
+
 	go db.run()
 
 	return db, nil
@@ -781,7 +832,7 @@ func (db *DB) compactHead(head *RangeHead) (err error) {
 	// Add +1 millisecond to block maxt because block intervals are half-open: [b.MinTime, b.MaxTime).
 	// Because of this block intervals are always +1 than the total samples it includes.
 	maxt := head.MaxTime() + 1
-	uid, err := db.compactor.Write(db.dir, head, head.MinTime(), maxt, nil)
+	uid, err := db.compactor.Write(db.dir, head, head.MinTime(), maxt, nil, SourceScrape)
 	if err != nil {
 		return errors.Wrap(err, "persist head block")
 	}
@@ -1218,6 +1269,7 @@ func (db *DB) Close() error {
 	close(db.stopc)
 	db.compactCancel()
 	<-db.donec
+	db.warmupWG.Wait()
 
 	db.mtx.Lock()
 	defer db.mtx.Unlock()
@@ -1294,26 +1346,56 @@ func (db *DB) Snapshot(dir string, withHead bool) error {
 	}
 	// Add +1 millisecond to block maxt because block intervals are half-open: [b.MinTime, b.MaxTime).
 	// Because of this block intervals are always +1 than the total samples it includes.
-	if _, err := db.compactor.Write(dir, head, mint, maxt+1, nil); err != nil {
+	if _, err := db.compactor.Write(dir, head, mint, maxt+1, nil, SourceScrape); err != nil {
 		return errors.Wrap(err, "snapshot head block")
 	}
 	return nil
 }
 
+// BlockMetaFilter decides whether a block should be excluded from queries,
+// based on its meta information.
+type BlockMetaFilter func(meta BlockMeta) bool
+
+// SetBlockMetaFilter installs a filter that excludes blocks from future
+// Querier calls when it returns true for a block's meta, e.g. to exclude
+// blocks by Source or ULID while validating backfilled data before trusting
+// it. Pass nil to clear the filter. It does not affect the head block.
+//
+// This only filters at the DB level; exposing Source/ULID exclusion as
+// query parameters on the HTTP API is a separate change on top of this.
+func (db *DB) SetBlockMetaFilter(f BlockMetaFilter) {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	db.blockMetaFilter = f
+}
+
 // Querier returns a new querier over the data partition for the given time range.
 // A goroutine must not handle more than one open Querier.
 func (db *DB) Querier(_ context.Context, mint, maxt int64) (storage.Querier, error) {
 	var blocks []BlockReader
 	var blockMetas []BlockMeta
 
+	// db.opts is nil for the ad-hoc DB DBReadOnly.Querier builds to reuse
+	// this method, so it cannot be dereferenced unconditionally.
+	var maxSeries int64
+	if db.opts != nil {
+		maxSeries = db.opts.MaxSeriesPerQuery
+	}
+
 	db.mtx.RLock()
 	defer db.mtx.RUnlock()
 
 	for _, b := range db.blocks {
-		if b.OverlapsClosedInterval(mint, maxt) {
-			blocks = append(blocks, b)
-			blockMetas = append(blockMetas, b.Meta())
+		if !b.OverlapsClosedInterval(mint, maxt) {
+			continue
+		}
+		meta := b.Meta()
+		if db.blockMetaFilter != nil && db.blockMetaFilter(meta) {
+			continue
 		}
+		blocks = append(blocks, b)
+		blockMetas = append(blockMetas, meta)
 	}
 	if maxt >= db.head.MinTime() {
 		blocks = append(blocks, &RangeHead{
@@ -1324,12 +1406,17 @@ func (db *DB) Querier(_ context.Context, mint, maxt int64) (storage.Querier, err
 	}
 
 	blockQueriers := make([]storage.Querier, 0, len(blocks))
+	var warnings storage.Warnings
 	for _, b := range blocks {
 		q, err := NewBlockQuerier(b, mint, maxt)
 		if err == nil {
 			blockQueriers = append(blockQueriers, q)
 			continue
 		}
+		if db.opts.AllowPartialBlockQueries {
+			warnings = append(warnings, errors.Wrapf(err, "skipping block %s while opening querier", b))
+			continue
+		}
 		// If we fail, all previously opened queriers must be closed.
 		for _, q := range blockQueriers {
 			q.Close()
@@ -1340,13 +1427,17 @@ func (db *DB) Querier(_ context.Context, mint, maxt int64) (storage.Querier, err
 	if len(OverlappingBlocks(blockMetas)) > 0 {
 		return &verticalQuerier{
 			querier: querier{
-				blocks: blockQueriers,
+				blocks:    blockQueriers,
+				warnings:  warnings,
+				maxSeries: maxSeries,
 			},
 		}, nil
 	}
 
 	return &querier{
-		blocks: blockQueriers,
+		blocks:    blockQueriers,
+		warnings:  warnings,
+		maxSeries: maxSeries,
 	}, nil
 }
 
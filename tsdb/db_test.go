@@ -1055,7 +1055,7 @@ type mockCompactorFailing struct {
 func (*mockCompactorFailing) Plan(dir string) ([]string, error) {
 	return nil, nil
 }
-func (c *mockCompactorFailing) Write(dest string, b BlockReader, mint, maxt int64, parent *BlockMeta) (ulid.ULID, error) {
+func (c *mockCompactorFailing) Write(dest string, b BlockReader, mint, maxt int64, parent *BlockMeta, source BlockMetaSource) (ulid.ULID, error) {
 	if len(c.blocks) >= c.max {
 		return ulid.ULID{}, fmt.Errorf("the compactor already did the maximum allowed blocks so it is time to fail")
 	}
@@ -1160,8 +1160,10 @@ func TestSizeRetention(t *testing.T) {
 	blockSize := int64(prom_testutil.ToFloat64(db.metrics.blocksBytes)) // Use the actual internal metrics.
 	walSize, err := db.Head().wal.Size()
 	testutil.Ok(t, err)
-	// Expected size should take into account block size + WAL size
-	expSize := blockSize + walSize
+	headChunksSize, err := fileutil.DirSize(mmappedChunksDir(db.Dir()))
+	testutil.Ok(t, err)
+	// Expected size should take into account block size + WAL size + on-disk head chunks size
+	expSize := blockSize + walSize + headChunksSize
 	actSize, err := fileutil.DirSize(db.Dir())
 	testutil.Ok(t, err)
 	testutil.Equals(t, expSize, actSize, "registered size doesn't match actual disk size")
@@ -1174,7 +1176,9 @@ func TestSizeRetention(t *testing.T) {
 	blockSize = int64(prom_testutil.ToFloat64(db.metrics.blocksBytes)) // Use the actual internal metrics.
 	walSize, err = db.Head().wal.Size()
 	testutil.Ok(t, err)
-	expSize = blockSize + walSize
+	headChunksSize, err = fileutil.DirSize(mmappedChunksDir(db.Dir()))
+	testutil.Ok(t, err)
+	expSize = blockSize + walSize + headChunksSize
 	actSize, err = fileutil.DirSize(db.Dir())
 	testutil.Ok(t, err)
 	testutil.Equals(t, expSize, actSize, "registered size doesn't match actual disk size")
@@ -1182,7 +1186,10 @@ func TestSizeRetention(t *testing.T) {
 	// Decrease the max bytes limit so that a delete is triggered.
 	// Check total size, total count and check that the oldest block was deleted.
 	firstBlockSize := db.Blocks()[0].Size()
-	sizeLimit := actSize - firstBlockSize
+	// beyondSizeRetention() measures head chunks via chunkDiskMapper.Size(),
+	// which can be ahead of what's actually been flushed to disk, so use the
+	// same accounting here rather than the on-disk headChunksSize above.
+	sizeLimit := blockSize + walSize + db.Head().chunkDiskMapper.Size() - firstBlockSize
 	db.opts.MaxBytes = sizeLimit // Set the new db size limit one block smaller that the actual size.
 	testutil.Ok(t, db.reload())  // Reload the db to register the new db size.
 
@@ -1191,8 +1198,10 @@ func TestSizeRetention(t *testing.T) {
 	blockSize = int64(prom_testutil.ToFloat64(db.metrics.blocksBytes))
 	walSize, err = db.Head().wal.Size()
 	testutil.Ok(t, err)
-	// Expected size should take into account block size + WAL size
-	expSize = blockSize + walSize
+	headChunksSize, err = fileutil.DirSize(mmappedChunksDir(db.Dir()))
+	testutil.Ok(t, err)
+	// Expected size should take into account block size + WAL size + on-disk head chunks size
+	expSize = blockSize + walSize + headChunksSize
 	actRetentionCount := int(prom_testutil.ToFloat64(db.metrics.sizeRetentionCount))
 	actSize, err = fileutil.DirSize(db.Dir())
 	testutil.Ok(t, err)
@@ -1506,6 +1515,126 @@ func TestQuerierWithBoundaryChunks(t *testing.T) {
 	testutil.Assert(t, count == 2, "expected 2 blocks in querier, got %d", count)
 }
 
+func TestDB_SetBlockMetaFilter(t *testing.T) {
+	db, closeFn := openTestDB(t, nil, nil)
+	defer func() {
+		testutil.Ok(t, db.Close())
+		closeFn()
+	}()
+
+	app := db.Appender()
+
+	blockRange := db.compactor.(*LeveledCompactor).ranges[0]
+	label := labels.FromStrings("foo", "bar")
+
+	for i := int64(0); i < 5; i++ {
+		_, err := app.Add(label, i*blockRange, 0)
+		testutil.Ok(t, err)
+	}
+	testutil.Ok(t, app.Commit())
+	testutil.Ok(t, db.Compact())
+
+	testutil.Assert(t, len(db.blocks) >= 3, "invalid test, less than three blocks in DB")
+
+	excluded := db.blocks[0].Meta().ULID
+	db.SetBlockMetaFilter(func(meta BlockMeta) bool {
+		return meta.ULID == excluded
+	})
+
+	// One persisted block excluded, plus the head block that's always
+	// appended for an open-ended query.
+	q, err := db.Querier(context.TODO(), math.MinInt64, math.MaxInt64)
+	testutil.Ok(t, err)
+	defer q.Close()
+	testutil.Equals(t, len(db.blocks), len(q.(*querier).blocks))
+
+	db.SetBlockMetaFilter(nil)
+
+	q, err = db.Querier(context.TODO(), math.MinInt64, math.MaxInt64)
+	testutil.Ok(t, err)
+	defer q.Close()
+	testutil.Equals(t, len(db.blocks)+1, len(q.(*querier).blocks))
+}
+
+func TestDB_Querier_AllowPartialBlockQueries(t *testing.T) {
+	db, closeFn := openTestDB(t, &Options{AllowPartialBlockQueries: true}, nil)
+	defer func() {
+		// db.blocks[0] is intentionally left closed below, so db.Close()
+		// is expected to report it rather than being asserted clean.
+		db.Close()
+		closeFn()
+	}()
+
+	app := db.Appender()
+
+	blockRange := db.compactor.(*LeveledCompactor).ranges[0]
+	label := labels.FromStrings("foo", "bar")
+
+	for i := int64(0); i < 5; i++ {
+		_, err := app.Add(label, i*blockRange, 0)
+		testutil.Ok(t, err)
+	}
+	testutil.Ok(t, app.Commit())
+	testutil.Ok(t, db.Compact())
+
+	testutil.Assert(t, len(db.blocks) >= 2, "invalid test, less than two blocks in DB")
+
+	// Simulate a corrupt/unreadable block by closing it underneath the DB;
+	// opening a querier against it will now fail.
+	testutil.Ok(t, db.blocks[0].Close())
+
+	q, err := db.Querier(context.TODO(), math.MinInt64, math.MaxInt64)
+	testutil.Ok(t, err)
+	defer q.Close()
+
+	// The broken block is skipped, so one fewer querier than persisted
+	// blocks, plus the always-appended head.
+	testutil.Equals(t, len(db.blocks), len(q.(*querier).blocks))
+
+	ss := q.Select(false, nil, labels.MustNewMatcher(labels.MatchEqual, "foo", "bar"))
+	for ss.Next() {
+	}
+	testutil.Ok(t, ss.Err())
+	testutil.Assert(t, len(ss.Warnings()) > 0, "expected a warning about the skipped block")
+}
+
+func TestDB_Querier_MaxSeriesPerQuery(t *testing.T) {
+	db, closeFn := openTestDB(t, &Options{MaxSeriesPerQuery: 2}, nil)
+	defer func() {
+		testutil.Ok(t, db.Close())
+		closeFn()
+	}()
+
+	app := db.Appender()
+	for i := 0; i < 3; i++ {
+		_, err := app.Add(labels.FromStrings("foo", "bar", "i", strconv.Itoa(i)), 0, 0)
+		testutil.Ok(t, err)
+	}
+	testutil.Ok(t, app.Commit())
+
+	q, err := db.Querier(context.TODO(), math.MinInt64, math.MaxInt64)
+	testutil.Ok(t, err)
+	defer q.Close()
+
+	ss := q.Select(false, nil, labels.MustNewMatcher(labels.MatchEqual, "foo", "bar"))
+	var got int
+	for ss.Next() {
+		got++
+	}
+	testutil.Assert(t, got <= 2, "expected Select to abort at the series limit, got %d series", got)
+	testutil.NotOk(t, ss.Err())
+	testutil.Equals(t, ErrTooManySeries{Limit: 2}, ss.Err())
+
+	// A tighter per-call limit via SelectHints is honored too.
+	ss = q.Select(false, &storage.SelectHints{SeriesLimit: 1}, labels.MustNewMatcher(labels.MatchEqual, "foo", "bar"))
+	got = 0
+	for ss.Next() {
+		got++
+	}
+	testutil.Assert(t, got <= 1, "expected SelectHints.SeriesLimit to win over the narrower of the two limits, got %d series", got)
+	testutil.NotOk(t, ss.Err())
+}
+
 // TestInitializeHeadTimestamp ensures that the h.minTime is set properly.
 // 	- no blocks no WAL: set to the time of the first  appended sample
 // 	- no blocks with WAL: set to the smallest sample from the WAL
@@ -0,0 +1,215 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package downsample generates aggregated, lower-resolution blocks from a
+// raw block, so that queries spanning long ranges can read a fraction of the
+// samples they otherwise would. It is meant to be run as an optional
+// post-processing step after compaction; selecting the appropriate
+// resolution for a given query range is left to the caller, e.g. a querier
+// that fans out to both the raw and downsampled blocks and picks whichever
+// is coarse enough for the query's range.
+package downsample
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/pkg/errors"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/tsdb"
+	"github.com/prometheus/prometheus/tsdb/chunks"
+	"github.com/prometheus/prometheus/tsdb/index"
+	"github.com/prometheus/prometheus/tsdb/tombstones"
+)
+
+// AggrLabel is added to every series written out by Downsample, to
+// disambiguate the aggregate a downsampled series holds. Its value is one of
+// the AggrType constants.
+const AggrLabel = "__aggr__"
+
+// AggrType identifies an aggregate computed over a downsampling window.
+type AggrType string
+
+// The aggregates Downsample can compute for a window.
+const (
+	AggrCount AggrType = "count"
+	AggrSum   AggrType = "sum"
+	AggrMin   AggrType = "min"
+	AggrMax   AggrType = "max"
+)
+
+// DefaultAggrs are written out when Downsample is called without an explicit
+// list of aggregates.
+var DefaultAggrs = []AggrType{AggrCount, AggrSum, AggrMin, AggrMax}
+
+// Resolutions commonly used for downsampled blocks, in milliseconds.
+const (
+	ResFiveMinutes = int64(5 * time.Minute / time.Millisecond)
+	ResOneHour     = int64(time.Hour / time.Millisecond)
+)
+
+type window struct {
+	count    int64
+	sum      float64
+	min, max float64
+}
+
+// Downsample reads every series in b and writes a new block to dir
+// containing, for each series and each non-empty window of length
+// resolution (in milliseconds), one sample per requested aggregate: the
+// count, sum, min or max of the raw samples falling in that window. A
+// window's timestamp is the window's start. If aggrs is empty, DefaultAggrs
+// is used.
+//
+// It returns the path to the written block, or an empty string if b
+// contained no samples.
+func Downsample(logger log.Logger, b tsdb.BlockReader, resolution int64, dir string, aggrs []AggrType) (string, error) {
+	if resolution <= 0 {
+		return "", errors.New("downsample resolution must be positive")
+	}
+	if len(aggrs) == 0 {
+		aggrs = DefaultAggrs
+	}
+
+	indexr, err := b.Index()
+	if err != nil {
+		return "", errors.Wrap(err, "open index")
+	}
+	defer indexr.Close()
+
+	chunkr, err := b.Chunks()
+	if err != nil {
+		return "", errors.Wrap(err, "open chunks")
+	}
+	defer chunkr.Close()
+
+	tombsr, err := b.Tombstones()
+	if err != nil {
+		return "", errors.Wrap(err, "open tombstones")
+	}
+	defer tombsr.Close()
+
+	k, v := index.AllPostingsKey()
+	p, err := indexr.Postings(k, v)
+	if err != nil {
+		return "", errors.Wrap(err, "read postings")
+	}
+	p = indexr.SortedPostings(p)
+
+	var (
+		samples    []*tsdb.MetricSample
+		mint, maxt = int64(math.MaxInt64), int64(math.MinInt64)
+		lset       labels.Labels
+		chks       []chunks.Meta
+	)
+	for p.Next() {
+		ref := p.At()
+
+		ivs, err := tombsr.Get(ref)
+		if err != nil {
+			return "", errors.Wrapf(err, "get tombstones for series %d", ref)
+		}
+		if err := indexr.Series(ref, &lset, &chks); err != nil {
+			return "", errors.Wrapf(err, "get series %d", ref)
+		}
+
+		windows := map[int64]*window{}
+		var starts []int64
+		for _, c := range chks {
+			chk, err := chunkr.Chunk(c.Ref)
+			if err != nil {
+				return "", errors.Wrapf(err, "read chunk %d", c.Ref)
+			}
+			it := chk.Iterator(nil)
+			for it.Next() {
+				t, val := it.At()
+				if intervalsContain(ivs, t) {
+					continue
+				}
+				start := t - (t % resolution)
+				w, ok := windows[start]
+				if !ok {
+					w = &window{min: val, max: val}
+					windows[start] = w
+					starts = append(starts, start)
+				}
+				w.count++
+				w.sum += val
+				if val < w.min {
+					w.min = val
+				}
+				if val > w.max {
+					w.max = val
+				}
+			}
+			if it.Err() != nil {
+				return "", errors.Wrapf(it.Err(), "iterate chunk %d", c.Ref)
+			}
+		}
+
+		sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+		for _, start := range starts {
+			w := windows[start]
+			for _, a := range aggrs {
+				out := append(lset.Copy(), labels.Label{Name: AggrLabel, Value: string(a)})
+				sort.Sort(out)
+				samples = append(samples, &tsdb.MetricSample{
+					Labels:      out,
+					TimestampMs: start,
+					Value:       aggrValue(a, w),
+				})
+			}
+			if start < mint {
+				mint = start
+			}
+			if start > maxt {
+				maxt = start
+			}
+		}
+	}
+	if p.Err() != nil {
+		return "", errors.Wrap(p.Err(), "iterate postings")
+	}
+	if len(samples) == 0 {
+		return "", nil
+	}
+
+	return tsdb.CreateBlock(samples, dir, mint, maxt+resolution, logger)
+}
+
+func aggrValue(a AggrType, w *window) float64 {
+	switch a {
+	case AggrCount:
+		return float64(w.count)
+	case AggrSum:
+		return w.sum
+	case AggrMin:
+		return w.min
+	case AggrMax:
+		return w.max
+	default:
+		return w.sum
+	}
+}
+
+func intervalsContain(ivs tombstones.Intervals, t int64) bool {
+	for _, iv := range ivs {
+		if iv.InBounds(t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package downsample
+
+import (
+	"testing"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/tsdb"
+	"github.com/prometheus/prometheus/util/testutil"
+)
+
+func TestDownsample_AggregatesWindows(t *testing.T) {
+	dir := testutil.NewTemporaryDirectory("test", t)
+	defer dir.Close()
+
+	samples := []*tsdb.MetricSample{
+		{Labels: labels.FromStrings("__name__", "a"), TimestampMs: 0, Value: 1},
+		{Labels: labels.FromStrings("__name__", "a"), TimestampMs: 1000, Value: 3},
+		{Labels: labels.FromStrings("__name__", "a"), TimestampMs: 5000, Value: 10},
+	}
+
+	blockDir, err := tsdb.CreateBlock(samples, dir.Path(), 0, 6000, log.NewNopLogger())
+	testutil.Ok(t, err)
+
+	b, err := tsdb.OpenBlock(log.NewNopLogger(), blockDir, nil)
+	testutil.Ok(t, err)
+	defer b.Close()
+
+	outDir := t.TempDir()
+	outBlockDir, err := Downsample(log.NewNopLogger(), b, 5000, outDir, nil)
+	testutil.Ok(t, err)
+	testutil.Assert(t, outBlockDir != "", "expected a block to be written")
+
+	out, err := tsdb.OpenBlock(log.NewNopLogger(), outBlockDir, nil)
+	testutil.Ok(t, err)
+	defer out.Close()
+
+	q, err := tsdb.NewBlockQuerier(out, 0, 10000)
+	testutil.Ok(t, err)
+	defer q.Close()
+
+	ss := q.Select(false, nil, labels.MustNewMatcher(labels.MatchEqual, "__name__", "a"))
+	got := map[string]map[int64]float64{}
+	for ss.Next() {
+		s := ss.At()
+		aggr := s.Labels().Get(AggrLabel)
+		if got[aggr] == nil {
+			got[aggr] = map[int64]float64{}
+		}
+		it := s.Iterator()
+		for it.Next() {
+			ts, v := it.At()
+			got[aggr][ts] = v
+		}
+		testutil.Ok(t, it.Err())
+	}
+	testutil.Ok(t, ss.Err())
+
+	testutil.Equals(t, map[int64]float64{0: 2, 5000: 1}, got[string(AggrCount)])
+	testutil.Equals(t, map[int64]float64{0: 4, 5000: 10}, got[string(AggrSum)])
+	testutil.Equals(t, map[int64]float64{0: 1, 5000: 10}, got[string(AggrMin)])
+	testutil.Equals(t, map[int64]float64{0: 3, 5000: 10}, got[string(AggrMax)])
+}
+
+func TestDownsample_EmptyBlock(t *testing.T) {
+	dir := testutil.NewTemporaryDirectory("test", t)
+	defer dir.Close()
+
+	samples := []*tsdb.MetricSample{
+		{Labels: labels.FromStrings("__name__", "a"), TimestampMs: 0, Value: 1},
+	}
+	blockDir, err := tsdb.CreateBlock(samples, dir.Path(), 0, 100, log.NewNopLogger())
+	testutil.Ok(t, err)
+
+	b, err := tsdb.OpenBlock(log.NewNopLogger(), blockDir, nil)
+	testutil.Ok(t, err)
+	defer b.Close()
+
+	outBlockDir, err := Downsample(log.NewNopLogger(), b, 0, t.TempDir(), nil)
+	testutil.NotOk(t, err)
+	testutil.Equals(t, "", outBlockDir)
+}
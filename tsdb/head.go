@@ -29,7 +29,10 @@ import (
 	"github.com/oklog/ulid"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+
 	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/logging"
 	"github.com/prometheus/prometheus/storage"
 	"github.com/prometheus/prometheus/tsdb/chunkenc"
 	"github.com/prometheus/prometheus/tsdb/chunks"
@@ -46,6 +49,11 @@ var (
 	ErrInvalidSample = errors.New("invalid sample")
 )
 
+// appendErrorLogInterval throttles the detailed out-of-bounds/out-of-order/
+// duplicate-sample diagnostics logged by headAppender, so that a
+// misconfigured scrape target or remote-write client can't flood the log.
+const appendErrorLogInterval = rate.Limit(1.0 / 15) // once per 15s
+
 // Head handles reads and writes of time series data within a time window.
 type Head struct {
 	// Keep all 64bit atomically accessed variables at the top of this struct.
@@ -56,13 +64,14 @@ type Head struct {
 	minValidTime     int64 // Mint allowed to be added to the head. It shouldn't be lower than the maxt of the last persisted block.
 	lastSeriesID     uint64
 
-	metrics      *headMetrics
-	wal          *wal.WAL
-	logger       log.Logger
-	appendPool   sync.Pool
-	seriesPool   sync.Pool
-	bytesPool    sync.Pool
-	memChunkPool sync.Pool
+	metrics           *headMetrics
+	wal               *wal.WAL
+	logger            log.Logger
+	appendErrorLogger log.Logger // Rate-limited logger used for detailed, per-sample append error diagnostics.
+	appendPool        sync.Pool
+	seriesPool        sync.Pool
+	bytesPool         sync.Pool
+	memChunkPool      sync.Pool
 
 	// All series addressable by their ID or hash.
 	series         *stripeSeries
@@ -95,27 +104,28 @@ type Head struct {
 }
 
 type headMetrics struct {
-	activeAppenders          prometheus.Gauge
-	series                   prometheus.GaugeFunc
-	seriesCreated            prometheus.Counter
-	seriesRemoved            prometheus.Counter
-	seriesNotFound           prometheus.Counter
-	chunks                   prometheus.Gauge
-	chunksCreated            prometheus.Counter
-	chunksRemoved            prometheus.Counter
-	gcDuration               prometheus.Summary
-	samplesAppended          prometheus.Counter
-	outOfBoundSamples        prometheus.Counter
-	outOfOrderSamples        prometheus.Counter
-	walTruncateDuration      prometheus.Summary
-	walCorruptionsTotal      prometheus.Counter
-	headTruncateFail         prometheus.Counter
-	headTruncateTotal        prometheus.Counter
-	checkpointDeleteFail     prometheus.Counter
-	checkpointDeleteTotal    prometheus.Counter
-	checkpointCreationFail   prometheus.Counter
-	checkpointCreationTotal  prometheus.Counter
-	mmapChunkCorruptionTotal prometheus.Counter
+	activeAppenders            prometheus.Gauge
+	series                     prometheus.GaugeFunc
+	seriesCreated              prometheus.Counter
+	seriesRemoved              prometheus.Counter
+	seriesNotFound             prometheus.Counter
+	chunks                     prometheus.Gauge
+	chunksCreated              prometheus.Counter
+	chunksRemoved              prometheus.Counter
+	gcDuration                 prometheus.Summary
+	samplesAppended            prometheus.Counter
+	outOfBoundSamples          prometheus.Counter
+	outOfOrderSamples          prometheus.Counter
+	walTruncateDuration        prometheus.Summary
+	walCorruptionsTotal        prometheus.Counter
+	headTruncateFail           prometheus.Counter
+	headTruncateTotal          prometheus.Counter
+	checkpointDeleteFail       prometheus.Counter
+	checkpointDeleteTotal      prometheus.Counter
+	checkpointCreationFail     prometheus.Counter
+	checkpointCreationTotal    prometheus.Counter
+	mmapChunkCorruptionTotal   prometheus.Counter
+	chunkSamplesPerChunkTarget prometheus.Histogram
 }
 
 func newHeadMetrics(h *Head, r prometheus.Registerer) *headMetrics {
@@ -206,6 +216,11 @@ func newHeadMetrics(h *Head, r prometheus.Registerer) *headMetrics {
 			Name: "prometheus_tsdb_mmap_chunk_corruptions_total",
 			Help: "Total number of memory-mapped chunk corruptions.",
 		}),
+		chunkSamplesPerChunkTarget: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "prometheus_tsdb_head_chunk_samples_per_chunk_target",
+			Help:    "Target number of samples per head chunk, as tuned to each series' observed scrape interval.",
+			Buckets: []float64{30, 60, 120, 240, 480},
+		}),
 	}
 
 	if r != nil {
@@ -231,6 +246,7 @@ func newHeadMetrics(h *Head, r prometheus.Registerer) *headMetrics {
 			m.checkpointCreationFail,
 			m.checkpointCreationTotal,
 			m.mmapChunkCorruptionTotal,
+			m.chunkSamplesPerChunkTarget,
 			// Metrics bound to functions and not needed in tests
 			// can be created and registered on the spot.
 			prometheus.NewGaugeFunc(prometheus.GaugeOpts{
@@ -286,7 +302,9 @@ func (h *Head) PostingsCardinalityStats(statsByLabelName string) *index.Postings
 // stripeSize sets the number of entries in the hash map, it must be a power of 2.
 // A larger stripeSize will allocate more memory up-front, but will increase performance when handling a large number of series.
 // A smaller stripeSize reduces the memory allocated, but can decrease performance with large number of series.
-func NewHead(r prometheus.Registerer, l log.Logger, wal *wal.WAL, chunkRange int64, chkDirRoot string, pool chunkenc.Pool, stripeSize int, seriesCallback SeriesLifecycleCallback) (*Head, error) {
+// chunkWriteQueueSize sets the size of the head chunk disk mapper's write
+// queue; 0 or less uses chunks.DefaultWriteQueueSize.
+func NewHead(r prometheus.Registerer, l log.Logger, wal *wal.WAL, chunkRange int64, chkDirRoot string, pool chunkenc.Pool, stripeSize int, chunkWriteQueueSize int, seriesCallback SeriesLifecycleCallback) (*Head, error) {
 	if l == nil {
 		l = log.NewNopLogger()
 	}
@@ -297,18 +315,19 @@ func NewHead(r prometheus.Registerer, l log.Logger, wal *wal.WAL, chunkRange int
 		seriesCallback = &noopSeriesLifecycleCallback{}
 	}
 	h := &Head{
-		wal:        wal,
-		logger:     l,
-		chunkRange: chunkRange,
-		minTime:    math.MaxInt64,
-		maxTime:    math.MinInt64,
-		series:     newStripeSeries(stripeSize, seriesCallback),
-		values:     map[string]stringset{},
-		symbols:    map[string]struct{}{},
-		postings:   index.NewUnorderedMemPostings(),
-		tombstones: tombstones.NewMemTombstones(),
-		iso:        newIsolation(),
-		deleted:    map[uint64]int{},
+		wal:               wal,
+		logger:            l,
+		appendErrorLogger: logging.RateLimit(l, appendErrorLogInterval),
+		chunkRange:        chunkRange,
+		minTime:           math.MaxInt64,
+		maxTime:           math.MinInt64,
+		series:            newStripeSeries(stripeSize, seriesCallback),
+		values:            map[string]stringset{},
+		symbols:           map[string]struct{}{},
+		postings:          index.NewUnorderedMemPostings(),
+		tombstones:        tombstones.NewMemTombstones(),
+		iso:               newIsolation(),
+		deleted:           map[uint64]int{},
 		memChunkPool: sync.Pool{
 			New: func() interface{} {
 				return &memChunk{}
@@ -322,9 +341,12 @@ func NewHead(r prometheus.Registerer, l log.Logger, wal *wal.WAL, chunkRange int
 	if pool == nil {
 		pool = chunkenc.NewPool()
 	}
+	if chunkWriteQueueSize <= 0 {
+		chunkWriteQueueSize = chunks.DefaultWriteQueueSize
+	}
 
 	var err error
-	h.chunkDiskMapper, err = chunks.NewChunkDiskMapper(mmappedChunksDir(chkDirRoot), pool)
+	h.chunkDiskMapper, err = chunks.NewChunkDiskMapperWithQueueSize(mmappedChunksDir(chkDirRoot), pool, r, chunkWriteQueueSize)
 	if err != nil {
 		return nil, err
 	}
@@ -1083,6 +1105,8 @@ type headAppender struct {
 func (a *headAppender) Add(lset labels.Labels, t int64, v float64) (uint64, error) {
 	if t < a.minValidTime {
 		a.head.metrics.outOfBoundSamples.Inc()
+		level.Warn(a.head.appendErrorLogger).Log("msg", "Sample timestamp out of bounds",
+			"series", lset.String(), "timestamp", t, "min_valid_time", a.minValidTime)
 		return 0, storage.ErrOutOfBounds
 	}
 
@@ -1111,12 +1135,17 @@ func (a *headAppender) Add(lset labels.Labels, t int64, v float64) (uint64, erro
 }
 
 func (a *headAppender) AddFast(ref uint64, t int64, v float64) error {
+	s := a.head.series.getByID(ref)
+
 	if t < a.minValidTime {
 		a.head.metrics.outOfBoundSamples.Inc()
+		if s != nil {
+			level.Warn(a.head.appendErrorLogger).Log("msg", "Sample timestamp out of bounds",
+				"series", s.lset.String(), "timestamp", t, "min_valid_time", a.minValidTime)
+		}
 		return storage.ErrOutOfBounds
 	}
 
-	s := a.head.series.getByID(ref)
 	if s == nil {
 		return errors.Wrap(storage.ErrNotFound, "unknown series")
 	}
@@ -1126,6 +1155,10 @@ func (a *headAppender) AddFast(ref uint64, t int64, v float64) error {
 		if err == storage.ErrOutOfOrderSample {
 			a.head.metrics.outOfOrderSamples.Inc()
 		}
+		if err == storage.ErrOutOfOrderSample || err == storage.ErrDuplicateSampleForTimestamp {
+			level.Warn(a.head.appendErrorLogger).Log("msg", "Sample out of order or duplicate",
+				"series", s.lset.String(), "timestamp", t, "err", err)
+		}
 		return err
 	}
 	s.pendingCommit = true
@@ -1676,7 +1709,7 @@ func (h *Head) getOrCreate(hash uint64, lset labels.Labels) (*memSeries, bool, e
 }
 
 func (h *Head) getOrCreateWithID(id, hash uint64, lset labels.Labels) (*memSeries, bool, error) {
-	s := newMemSeries(lset, id, h.chunkRange, &h.memChunkPool)
+	s := newMemSeries(lset, id, h.chunkRange, &h.memChunkPool, h.metrics)
 
 	s, created, err := h.series.getOrSet(hash, s)
 	if err != nil {
@@ -1752,6 +1785,10 @@ func (m seriesHashmap) del(hash uint64, lset labels.Labels) {
 const (
 	// DefaultStripeSize is the default number of entries to allocate in the stripeSeries hash map.
 	DefaultStripeSize = 1 << 14
+
+	// DefaultHeadChunkWriteQueueSize is the default size of the head chunk
+	// disk mapper's write queue. See chunks.DefaultWriteQueueSize.
+	DefaultHeadChunkWriteQueueSize = chunks.DefaultWriteQueueSize
 )
 
 // stripeSeries locks modulo ranges of IDs and hashes to reduce lock contention.
@@ -1934,10 +1971,14 @@ type memSeries struct {
 
 	memChunkPool *sync.Pool
 
+	// metrics is used to report the head chunk size heuristic. It may be nil,
+	// in which case nothing is reported.
+	metrics *headMetrics
+
 	txs *txRing
 }
 
-func newMemSeries(lset labels.Labels, id uint64, chunkRange int64, memChunkPool *sync.Pool) *memSeries {
+func newMemSeries(lset labels.Labels, id uint64, chunkRange int64, memChunkPool *sync.Pool, metrics *headMetrics) *memSeries {
 	s := &memSeries{
 		lset:         lset,
 		ref:          id,
@@ -1945,6 +1986,7 @@ func newMemSeries(lset labels.Labels, id uint64, chunkRange int64, memChunkPool
 		nextAt:       math.MinInt64,
 		txs:          newTxRing(4),
 		memChunkPool: memChunkPool,
+		metrics:      metrics,
 	}
 	return s
 }
@@ -2095,11 +2137,6 @@ func (s *memSeries) truncateChunksBefore(mint int64) (removed int) {
 // isolation for this append.)
 // It is unsafe to call this concurrently with s.iterator(...) without holding the series lock.
 func (s *memSeries) append(t int64, v float64, appendID uint64, chunkDiskMapper *chunks.ChunkDiskMapper) (sampleInOrder, chunkCreated bool) {
-	// Based on Gorilla white papers this offers near-optimal compression ratio
-	// so anything bigger that this has diminishing returns and increases
-	// the time range within which we have to decompress all samples.
-	const samplesPerChunk = 120
-
 	c := s.head()
 
 	if c == nil {
@@ -2117,11 +2154,23 @@ func (s *memSeries) append(t int64, v float64, appendID uint64, chunkDiskMapper
 	if c.maxTime >= t {
 		return false, chunkCreated
 	}
+	// Target this chunk's sample count to the series' own observed scrape
+	// interval instead of a fixed number, so slow series (few samples over a
+	// long time range) pack more samples into a chunk for better compression,
+	// while fast series get cut sooner for finer-grained lookups.
+	var interval int64
+	if numSamples > 1 {
+		interval = (c.maxTime - c.minTime) / int64(numSamples-1)
+	}
+	samplesPerChunk := targetSamplesPerChunk(interval)
 	// If we reach 25% of a chunk's desired sample count, set a definitive time
 	// at which to start the next chunk.
 	// At latest it must happen at the timestamp set when the chunk was cut.
 	if numSamples == samplesPerChunk/4 {
 		s.nextAt = computeChunkEndTime(c.minTime, c.maxTime, s.nextAt)
+		if s.metrics != nil {
+			s.metrics.chunkSamplesPerChunkTarget.Observe(float64(samplesPerChunk))
+		}
 	}
 	if t >= s.nextAt {
 		c = s.cutNewHeadChunk(t, chunkDiskMapper)
@@ -2149,6 +2198,43 @@ func (s *memSeries) cleanupAppendIDsBelow(bound uint64) {
 	s.txs.cleanupAppendIDsBelow(bound)
 }
 
+const (
+	// defaultSamplesPerChunk is the chunk size target used when a series'
+	// scrape interval isn't known yet (its first chunk) or falls back to the
+	// classic, interval-agnostic value Gorilla-style chunks have always used.
+	defaultSamplesPerChunk = 120
+	// minSamplesPerChunk and maxSamplesPerChunk bound how far
+	// targetSamplesPerChunk will scale the target away from
+	// defaultSamplesPerChunk, so that pathologically fast or slow series
+	// can't produce chunks that are too small to be worth the overhead or
+	// too large to query efficiently.
+	minSamplesPerChunk = 30
+	maxSamplesPerChunk = 480
+	// baselineScrapeIntervalMillis is the scrape interval defaultSamplesPerChunk
+	// was originally tuned for.
+	baselineScrapeIntervalMillis = int64(60 * 1000)
+)
+
+// targetSamplesPerChunk returns the number of samples a newly cut chunk
+// should aim to hold, given the interval (in milliseconds) observed between
+// a series' samples so far. Series with a longer interval get a larger
+// target, since their chunks otherwise span little time and hold few
+// samples relative to their overhead; series with a shorter interval get a
+// smaller target, trading some compression for finer-grained lookups.
+func targetSamplesPerChunk(intervalMillis int64) int {
+	if intervalMillis <= 0 {
+		return defaultSamplesPerChunk
+	}
+	target := defaultSamplesPerChunk * intervalMillis / baselineScrapeIntervalMillis
+	switch {
+	case target < minSamplesPerChunk:
+		return minSamplesPerChunk
+	case target > maxSamplesPerChunk:
+		return maxSamplesPerChunk
+	}
+	return int(target)
+}
+
 // computeChunkEndTime estimates the end timestamp based the beginning of a
 // chunk, its current timestamp and the upper bound up to which we insert data.
 // It assumes that the time range is 1/4 full.
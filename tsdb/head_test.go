@@ -173,7 +173,7 @@ func BenchmarkLoadWAL(b *testing.B) {
 
 				// Load the WAL.
 				for i := 0; i < b.N; i++ {
-					h, err := NewHead(nil, nil, w, 1000, w.Dir(), nil, DefaultStripeSize, nil)
+					h, err := NewHead(nil, nil, w, 1000, w.Dir(), nil, DefaultStripeSize, DefaultHeadChunkWriteQueueSize, nil)
 					testutil.Ok(b, err)
 					h.Init(0)
 				}
@@ -286,7 +286,7 @@ func TestHead_WALMultiRef(t *testing.T) {
 	w, err = wal.New(nil, nil, w.Dir(), false)
 	testutil.Ok(t, err)
 
-	head, err = NewHead(nil, nil, w, 1000, w.Dir(), nil, DefaultStripeSize, nil)
+	head, err = NewHead(nil, nil, w, 1000, w.Dir(), nil, DefaultStripeSize, DefaultHeadChunkWriteQueueSize, nil)
 	testutil.Ok(t, err)
 	testutil.Ok(t, head.Init(0))
 	defer func() {
@@ -389,7 +389,7 @@ func TestMemSeries_truncateChunks(t *testing.T) {
 		testutil.Ok(t, os.RemoveAll(dir))
 	}()
 	// This is usually taken from the Head, but passing manually here.
-	chunkDiskMapper, err := chunks.NewChunkDiskMapper(dir, chunkenc.NewPool())
+	chunkDiskMapper, err := chunks.NewChunkDiskMapper(dir, chunkenc.NewPool(), nil)
 	testutil.Ok(t, err)
 	defer func() {
 		testutil.Ok(t, chunkDiskMapper.Close())
@@ -401,7 +401,7 @@ func TestMemSeries_truncateChunks(t *testing.T) {
 		},
 	}
 
-	s := newMemSeries(labels.FromStrings("a", "b"), 1, 2000, &memChunkPool)
+	s := newMemSeries(labels.FromStrings("a", "b"), 1, 2000, &memChunkPool, nil)
 
 	for i := 0; i < 4000; i += 5 {
 		ok, _ := s.append(int64(i), float64(i), 0, chunkDiskMapper)
@@ -553,7 +553,7 @@ func TestHeadDeleteSimple(t *testing.T) {
 				// Compare the samples for both heads - before and after the reload.
 				reloadedW, err := wal.New(nil, nil, w.Dir(), compress) // Use a new wal to ensure deleted samples are gone even after a reload.
 				testutil.Ok(t, err)
-				reloadedHead, err := NewHead(nil, nil, reloadedW, 1000, reloadedW.Dir(), nil, DefaultStripeSize, nil)
+				reloadedHead, err := NewHead(nil, nil, reloadedW, 1000, reloadedW.Dir(), nil, DefaultStripeSize, DefaultHeadChunkWriteQueueSize, nil)
 				testutil.Ok(t, err)
 				testutil.Ok(t, reloadedHead.Init(0))
 
@@ -939,13 +939,13 @@ func TestMemSeries_append(t *testing.T) {
 		testutil.Ok(t, os.RemoveAll(dir))
 	}()
 	// This is usually taken from the Head, but passing manually here.
-	chunkDiskMapper, err := chunks.NewChunkDiskMapper(dir, chunkenc.NewPool())
+	chunkDiskMapper, err := chunks.NewChunkDiskMapper(dir, chunkenc.NewPool(), nil)
 	testutil.Ok(t, err)
 	defer func() {
 		testutil.Ok(t, chunkDiskMapper.Close())
 	}()
 
-	s := newMemSeries(labels.Labels{}, 1, 500, nil)
+	s := newMemSeries(labels.Labels{}, 1, 500, nil, nil)
 
 	// Add first two samples at the very end of a chunk range and the next two
 	// on and after it.
@@ -970,8 +970,10 @@ func TestMemSeries_append(t *testing.T) {
 	testutil.Assert(t, s.mmappedChunks[0].minTime == 998 && s.mmappedChunks[0].maxTime == 999, "wrong chunk range")
 	testutil.Assert(t, s.headChunk.minTime == 1000 && s.headChunk.maxTime == 1001, "wrong chunk range")
 
-	// Fill the range [1000,2000) with many samples. Intermediate chunks should be cut
-	// at approximately 120 samples per chunk.
+	// Fill the range [1000,2000) with many samples, one per millisecond.
+	// Such a fast interval drives targetSamplesPerChunk down to
+	// minSamplesPerChunk, so intermediate chunks should be cut at
+	// approximately that many samples instead of the classic 120.
 	for i := 1; i < 1000; i++ {
 		ok, _ := s.append(1001+int64(i), float64(i), 0, chunkDiskMapper)
 		testutil.Assert(t, ok, "append failed")
@@ -983,7 +985,7 @@ func TestMemSeries_append(t *testing.T) {
 	for i, c := range s.mmappedChunks[1:] {
 		chk, err := chunkDiskMapper.Chunk(c.ref)
 		testutil.Ok(t, err)
-		testutil.Assert(t, chk.NumSamples() > 100, "unexpected small chunk %d of length %d", i, chk.NumSamples())
+		testutil.Assert(t, chk.NumSamples() > minSamplesPerChunk/2, "unexpected small chunk %d of length %d", i, chk.NumSamples())
 	}
 }
 
@@ -1256,7 +1258,7 @@ func TestWalRepair_DecodingError(t *testing.T) {
 						testutil.Ok(t, w.Log(test.rec))
 					}
 
-					h, err := NewHead(nil, nil, w, 1, w.Dir(), nil, DefaultStripeSize, nil)
+					h, err := NewHead(nil, nil, w, 1, w.Dir(), nil, DefaultStripeSize, DefaultHeadChunkWriteQueueSize, nil)
 					testutil.Ok(t, err)
 					testutil.Equals(t, 0.0, prom_testutil.ToFloat64(h.metrics.walCorruptionsTotal))
 					initErr := h.Init(math.MinInt64)
@@ -1311,7 +1313,7 @@ func TestHeadReadWriterRepair(t *testing.T) {
 		w, err := wal.New(nil, nil, walDir, false)
 		testutil.Ok(t, err)
 
-		h, err := NewHead(nil, nil, w, chunkRange, dir, nil, DefaultStripeSize, nil)
+		h, err := NewHead(nil, nil, w, chunkRange, dir, nil, DefaultStripeSize, DefaultHeadChunkWriteQueueSize, nil)
 		testutil.Ok(t, err)
 		testutil.Equals(t, 0.0, prom_testutil.ToFloat64(h.metrics.mmapChunkCorruptionTotal))
 		testutil.Ok(t, h.Init(math.MinInt64))
@@ -1542,7 +1544,7 @@ func TestMemSeriesIsolation(t *testing.T) {
 
 	wlog, err := wal.NewSize(nil, nil, w.Dir(), 32768, false)
 	testutil.Ok(t, err)
-	hb, err = NewHead(nil, nil, wlog, 1000, wlog.Dir(), nil, DefaultStripeSize, nil)
+	hb, err = NewHead(nil, nil, wlog, 1000, wlog.Dir(), nil, DefaultStripeSize, DefaultHeadChunkWriteQueueSize, nil)
 	defer func() { testutil.Ok(t, hb.Close()) }()
 	testutil.Ok(t, err)
 	testutil.Ok(t, hb.Init(0))
@@ -1806,7 +1808,7 @@ func newTestHead(t testing.TB, chunkRange int64, compressWAL bool) (*Head, *wal.
 	wlog, err := wal.NewSize(nil, nil, filepath.Join(dir, "wal"), 32768, compressWAL)
 	testutil.Ok(t, err)
 
-	h, err := NewHead(nil, nil, wlog, chunkRange, dir, nil, DefaultStripeSize, nil)
+	h, err := NewHead(nil, nil, wlog, chunkRange, dir, nil, DefaultStripeSize, DefaultHeadChunkWriteQueueSize, nil)
 	testutil.Ok(t, err)
 
 	testutil.Ok(t, h.chunkDiskMapper.IterateAllChunks(func(_, _ uint64, _, _ int64, _ uint16) error { return nil }))
@@ -14,6 +14,7 @@
 package tsdb
 
 import (
+	"fmt"
 	"sort"
 	"strings"
 	"unicode/utf8"
@@ -32,16 +33,89 @@ import (
 // a single partition.
 type querier struct {
 	blocks []storage.Querier
+
+	// warnings carries issues that happened while assembling blocks, e.g. a
+	// block that was skipped rather than failing the whole query because the
+	// DB was opened with Options.AllowPartialBlockQueries. It is surfaced
+	// through every method that already returns storage.Warnings.
+	warnings storage.Warnings
+
+	// maxSeries is the global per-query series limit configured via
+	// Options.MaxSeriesPerQuery. 0 means no limit. Select additionally
+	// honors a tighter, call-specific storage.SelectHints.SeriesLimit.
+	maxSeries int64
+}
+
+// ErrTooManySeries is returned by Select once a query has touched more
+// series than the limit in effect allows.
+type ErrTooManySeries struct {
+	Limit int64
+}
+
+func (e ErrTooManySeries) Error() string {
+	return fmt.Sprintf("query processing would touch too many series, limit %d", e.Limit)
+}
+
+// seriesLimit returns the effective per-Select series limit: the tighter of
+// the querier's global maxSeries and the call's hints.SeriesLimit, if either
+// is set. 0 means unlimited.
+func seriesLimit(maxSeries int64, hints *storage.SelectHints) int64 {
+	limit := maxSeries
+	if hints != nil && hints.SeriesLimit > 0 && (limit <= 0 || hints.SeriesLimit < limit) {
+		limit = hints.SeriesLimit
+	}
+	return limit
+}
+
+// limitSeriesSet wraps a storage.SeriesSet and aborts with ErrTooManySeries
+// once more than limit series have been returned, before the caller gets a
+// chance to decode any chunks for series beyond the limit.
+type limitSeriesSet struct {
+	storage.SeriesSet
+	limit int64
+	count int64
+	err   error
+}
+
+func (s *limitSeriesSet) Next() bool {
+	if s.err != nil {
+		return false
+	}
+	if s.count >= s.limit {
+		s.err = ErrTooManySeries{Limit: s.limit}
+		return false
+	}
+	if !s.SeriesSet.Next() {
+		return false
+	}
+	s.count++
+	return true
+}
+
+func (s *limitSeriesSet) Err() error {
+	if s.err != nil {
+		return s.err
+	}
+	return s.SeriesSet.Err()
+}
+
+// withSeriesLimit wraps ss with a series limit if limit is set (> 0).
+func withSeriesLimit(ss storage.SeriesSet, limit int64) storage.SeriesSet {
+	if limit <= 0 {
+		return ss
+	}
+	return &limitSeriesSet{SeriesSet: ss, limit: limit}
 }
 
 func (q *querier) LabelValues(n string) ([]string, storage.Warnings, error) {
-	return q.lvals(q.blocks, n)
+	vals, ws, err := q.lvals(q.blocks, n)
+	return vals, append(ws, q.warnings...), err
 }
 
 // LabelNames returns all the unique label names present querier blocks.
 func (q *querier) LabelNames() ([]string, storage.Warnings, error) {
 	labelNamesMap := make(map[string]struct{})
-	var ws storage.Warnings
+	ws := append(storage.Warnings{}, q.warnings...)
 	for _, b := range q.blocks {
 		names, w, err := b.LabelNames()
 		ws = append(ws, w...)
@@ -86,22 +160,42 @@ func (q *querier) lvals(qs []storage.Querier, n string) ([]string, storage.Warni
 }
 
 func (q *querier) Select(sortSeries bool, hints *storage.SelectHints, ms ...*labels.Matcher) storage.SeriesSet {
-	if len(q.blocks) == 0 {
-		return storage.EmptySeriesSet()
-	}
-	if len(q.blocks) == 1 {
+	var base storage.SeriesSet
+	switch {
+	case len(q.blocks) == 0:
+		base = storage.EmptySeriesSet()
+	case len(q.blocks) == 1:
 		// Sorting Head series is slow, and unneeded when only the
 		// Head is being queried.
-		return q.blocks[0].Select(sortSeries, hints, ms...)
+		base = q.blocks[0].Select(sortSeries, hints, ms...)
+	default:
+		ss := make([]storage.SeriesSet, len(q.blocks))
+		for i, b := range q.blocks {
+			// We have to sort if blocks > 1 as MergedSeriesSet requires it.
+			ss[i] = b.Select(true, hints, ms...)
+		}
+		base = NewMergedSeriesSet(ss)
 	}
+	base = withSeriesLimit(base, seriesLimit(q.maxSeries, hints))
+	return withExtraWarnings(base, q.warnings)
+}
 
-	ss := make([]storage.SeriesSet, len(q.blocks))
-	for i, b := range q.blocks {
-		// We have to sort if blocks > 1 as MergedSeriesSet requires it.
-		ss[i] = b.Select(true, hints, ms...)
+// withExtraWarnings wraps ss so that its Warnings() also reports extra,
+// without affecting iteration. It returns ss unchanged if extra is empty.
+func withExtraWarnings(ss storage.SeriesSet, extra storage.Warnings) storage.SeriesSet {
+	if len(extra) == 0 {
+		return ss
 	}
+	return warnWrappedSeriesSet{SeriesSet: ss, extra: extra}
+}
+
+type warnWrappedSeriesSet struct {
+	storage.SeriesSet
+	extra storage.Warnings
+}
 
-	return NewMergedSeriesSet(ss)
+func (s warnWrappedSeriesSet) Warnings() storage.Warnings {
+	return append(append(storage.Warnings{}, s.extra...), s.SeriesSet.Warnings()...)
 }
 
 func (q *querier) Close() error {
@@ -120,7 +214,8 @@ type verticalQuerier struct {
 }
 
 func (q *verticalQuerier) Select(sortSeries bool, hints *storage.SelectHints, ms ...*labels.Matcher) storage.SeriesSet {
-	return q.sel(sortSeries, hints, q.blocks, ms)
+	base := withSeriesLimit(q.sel(sortSeries, hints, q.blocks, ms), seriesLimit(q.maxSeries, hints))
+	return withExtraWarnings(base, q.warnings)
 }
 
 func (q *verticalQuerier) sel(sortSeries bool, hints *storage.SelectHints, qs []storage.Querier, ms []*labels.Matcher) storage.SeriesSet {
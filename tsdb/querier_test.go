@@ -1869,7 +1869,7 @@ func TestPostingsForMatchers(t *testing.T) {
 	defer func() {
 		testutil.Ok(t, os.RemoveAll(chunkDir))
 	}()
-	h, err := NewHead(nil, nil, nil, 1000, chunkDir, nil, DefaultStripeSize, nil)
+	h, err := NewHead(nil, nil, nil, 1000, chunkDir, nil, DefaultStripeSize, DefaultHeadChunkWriteQueueSize, nil)
 	testutil.Ok(t, err)
 	defer func() {
 		testutil.Ok(t, h.Close())
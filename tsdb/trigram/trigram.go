@@ -0,0 +1,124 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package trigram computes and queries an optional per-block trigram index
+// over label values. An unanchored regex matcher, e.g. `=~".*foo.*"`, can
+// consult the index to narrow the set of label values worth running the
+// regexp against instead of evaluating it over every value in the block.
+// The index is kept separate from a block's main index so blocks built
+// without it are unaffected; building it is opt-in, since it adds index
+// size and write-time cost.
+//
+// This package only builds the index and answers candidate-value queries --
+// wiring it into block writing (as a feature flag) and query planning, and
+// reporting its size alongside the rest of a block's index, is left to the
+// caller.
+package trigram
+
+import "sort"
+
+// Index maps each trigram found in a set of label values to the values that
+// contain it, so MatchingValues can intersect postings instead of scanning
+// every value.
+type Index struct {
+	postings map[string][]string
+}
+
+// Build returns an Index over values. values need not be sorted or unique.
+func Build(values []string) *Index {
+	idx := &Index{postings: make(map[string][]string)}
+	seen := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		for t := range trigrams(v) {
+			idx.postings[t] = append(idx.postings[t], v)
+		}
+	}
+	for _, vs := range idx.postings {
+		sort.Strings(vs)
+	}
+	return idx
+}
+
+// Size returns the approximate number of bytes the index occupies, for
+// reporting alongside the rest of a block's index size.
+func (idx *Index) Size() int {
+	size := 0
+	for t, vs := range idx.postings {
+		size += len(t)
+		for _, v := range vs {
+			size += len(v)
+		}
+	}
+	return size
+}
+
+// MatchingValues returns the label values that could possibly satisfy an
+// unanchored regexp containing the literal substring needle, by intersecting
+// the postings of every trigram in needle. The regexp itself must still be
+// evaluated against the result, since a trigram match does not guarantee the
+// full pattern matches; if needle has no extractable trigrams (it is shorter
+// than 3 bytes), ok is false and the index cannot narrow the search.
+func (idx *Index) MatchingValues(needle string) (values []string, ok bool) {
+	needed := make([]string, 0, len(needle))
+	for t := range trigrams(needle) {
+		needed = append(needed, t)
+	}
+	if len(needed) == 0 {
+		return nil, false
+	}
+
+	result := idx.postings[needed[0]]
+	for _, t := range needed[1:] {
+		if len(result) == 0 {
+			break
+		}
+		result = intersect(result, idx.postings[t])
+	}
+	return result, true
+}
+
+// trigrams returns the set of distinct 3-byte substrings of s.
+func trigrams(s string) map[string]struct{} {
+	if len(s) < 3 {
+		return nil
+	}
+	out := make(map[string]struct{}, len(s)-2)
+	for i := 0; i+3 <= len(s); i++ {
+		out[s[i:i+3]] = struct{}{}
+	}
+	return out
+}
+
+// intersect returns the sorted intersection of two sorted, duplicate-free
+// string slices.
+func intersect(a, b []string) []string {
+	out := make([]string, 0, len(a))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			out = append(out, a[i])
+			i++
+			j++
+		}
+	}
+	return out
+}
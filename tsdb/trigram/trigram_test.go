@@ -0,0 +1,68 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigram
+
+import (
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/prometheus/prometheus/util/testutil"
+)
+
+func TestBuildAndMatchingValues(t *testing.T) {
+	values := []string{"foobar", "barfoo", "quux", "foo"}
+	idx := Build(values)
+
+	got, ok := idx.MatchingValues("foo")
+	testutil.Assert(t, ok, "expected ok for a 3+ byte needle")
+	sort.Strings(got)
+	testutil.Equals(t, []string{"barfoo", "foo", "foobar"}, got)
+
+	got, ok = idx.MatchingValues("zzz")
+	testutil.Assert(t, ok, "expected ok even with no matches")
+	testutil.Equals(t, []string(nil), got)
+}
+
+func TestMatchingValuesShortNeedle(t *testing.T) {
+	idx := Build([]string{"foobar"})
+	_, ok := idx.MatchingValues("fo")
+	testutil.Assert(t, !ok, "expected ok=false for a needle shorter than 3 bytes")
+}
+
+func TestMatchingValuesNeedsRegexpConfirmation(t *testing.T) {
+	// The index only narrows candidates; regexp evaluation against the
+	// remaining set is still required to get a correct final answer.
+	idx := Build([]string{"foobar", "foobaz"})
+	re := regexp.MustCompile(".*foobar.*")
+
+	candidates, ok := idx.MatchingValues("foobar")
+	testutil.Assert(t, ok, "expected ok")
+
+	var matched []string
+	for _, v := range candidates {
+		if re.MatchString(v) {
+			matched = append(matched, v)
+		}
+	}
+	testutil.Equals(t, []string{"foobar"}, matched)
+}
+
+func TestSize(t *testing.T) {
+	idx := Build([]string{"foobar"})
+	testutil.Assert(t, idx.Size() > 0, "expected a positive size for a non-empty index")
+
+	empty := Build(nil)
+	testutil.Equals(t, 0, empty.Size())
+}
@@ -33,7 +33,7 @@ type MetricSample struct {
 
 // CreateHead creates a TSDB writer head to write the sample data to.
 func CreateHead(samples []*MetricSample, chunkRange int64, chunkDir string, logger log.Logger) (*Head, error) {
-	head, err := NewHead(nil, logger, nil, chunkRange, chunkDir, nil, DefaultStripeSize, nil)
+	head, err := NewHead(nil, logger, nil, chunkRange, chunkDir, nil, DefaultStripeSize, DefaultHeadChunkWriteQueueSize, nil)
 
 	if err != nil {
 		return nil, err
@@ -81,7 +81,7 @@ func CreateBlock(samples []*MetricSample, dir string, mint, maxt int64, logger l
 		return "", err
 	}
 
-	ulid, err := compactor.Write(dir, head, mint, maxt, nil)
+	ulid, err := compactor.Write(dir, head, mint, maxt, nil, SourceBackfill)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,158 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package validate checks the structural invariants of a TSDB block -- that
+// its index, chunks and tombstones agree with each other -- without needing
+// to load the block into a full storage.DB. It is intended for tooling that
+// wants to validate blocks before or after moving them around, e.g. backup
+// and restore utilities.
+package validate
+
+import (
+	"fmt"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/tsdb"
+	"github.com/prometheus/prometheus/tsdb/chunks"
+	"github.com/prometheus/prometheus/tsdb/index"
+	"github.com/prometheus/prometheus/tsdb/tombstones"
+)
+
+// IssueType classifies a single problem found while validating a block.
+type IssueType string
+
+const (
+	// IssueDanglingChunkRef means a series' chunk meta points at chunk data
+	// that could not be read back from the chunk files.
+	IssueDanglingChunkRef IssueType = "dangling_chunk_ref"
+	// IssueOutOfOrderChunks means a series has two chunks whose time ranges
+	// are not in increasing, non-overlapping order.
+	IssueOutOfOrderChunks IssueType = "out_of_order_chunks"
+	// IssueUnreadableSeries means the index postings reference a series that
+	// could not be resolved via IndexReader.Series.
+	IssueUnreadableSeries IssueType = "unreadable_series"
+	// IssueTombstoneUnknownSeries means a tombstone references a series
+	// reference that the index has no record of.
+	IssueTombstoneUnknownSeries IssueType = "tombstone_unknown_series"
+)
+
+// Issue describes a single invariant violation found in a block.
+type Issue struct {
+	Type      IssueType
+	SeriesRef uint64
+	Labels    labels.Labels
+	Detail    string
+}
+
+func (i Issue) String() string {
+	if len(i.Labels) == 0 {
+		return fmt.Sprintf("%s: series %d: %s", i.Type, i.SeriesRef, i.Detail)
+	}
+	return fmt.Sprintf("%s: series %d %s: %s", i.Type, i.SeriesRef, i.Labels, i.Detail)
+}
+
+// Block opens the block at dir and validates it. It returns an error only if
+// the block itself could not be opened or read; structural problems within a
+// readable block are returned as Issues.
+func Block(dir string) ([]Issue, error) {
+	b, err := tsdb.OpenBlock(log.NewNopLogger(), dir, nil)
+	if err != nil {
+		return nil, fmt.Errorf("open block: %w", err)
+	}
+	defer b.Close()
+
+	return BlockReader(b)
+}
+
+// BlockReader validates a block via the generic tsdb.BlockReader interface,
+// so it can be used directly against blocks already opened by a caller.
+func BlockReader(b tsdb.BlockReader) ([]Issue, error) {
+	ir, err := b.Index()
+	if err != nil {
+		return nil, fmt.Errorf("open index: %w", err)
+	}
+	defer ir.Close()
+
+	cr, err := b.Chunks()
+	if err != nil {
+		return nil, fmt.Errorf("open chunks: %w", err)
+	}
+	defer cr.Close()
+
+	tr, err := b.Tombstones()
+	if err != nil {
+		return nil, fmt.Errorf("open tombstones: %w", err)
+	}
+	defer tr.Close()
+
+	var issues []Issue
+
+	k, v := index.AllPostingsKey()
+	p, err := ir.Postings(k, v)
+	if err != nil {
+		return nil, fmt.Errorf("read postings: %w", err)
+	}
+
+	seen := map[uint64]struct{}{}
+	for p.Next() {
+		ref := p.At()
+		seen[ref] = struct{}{}
+
+		var (
+			lset labels.Labels
+			chks []chunks.Meta
+		)
+		if err := ir.Series(ref, &lset, &chks); err != nil {
+			issues = append(issues, Issue{Type: IssueUnreadableSeries, SeriesRef: ref, Detail: err.Error()})
+			continue
+		}
+
+		lastMaxT := int64(-1)
+		for _, c := range chks {
+			if c.MinTime <= lastMaxT {
+				issues = append(issues, Issue{
+					Type:      IssueOutOfOrderChunks,
+					SeriesRef: ref,
+					Labels:    lset,
+					Detail:    fmt.Sprintf("chunk [%d,%d] overlaps or precedes previous chunk ending at %d", c.MinTime, c.MaxTime, lastMaxT),
+				})
+			}
+			lastMaxT = c.MaxTime
+
+			if _, err := cr.Chunk(c.Ref); err != nil {
+				issues = append(issues, Issue{
+					Type:      IssueDanglingChunkRef,
+					SeriesRef: ref,
+					Labels:    lset,
+					Detail:    err.Error(),
+				})
+			}
+		}
+	}
+	if err := p.Err(); err != nil {
+		return issues, fmt.Errorf("iterate postings: %w", err)
+	}
+
+	if err := tr.Iter(func(ref uint64, _ tombstones.Intervals) error {
+		if _, ok := seen[ref]; !ok {
+			issues = append(issues, Issue{Type: IssueTombstoneUnknownSeries, SeriesRef: ref, Detail: "tombstone references a series absent from the index"})
+		}
+		return nil
+	}); err != nil {
+		return issues, fmt.Errorf("iterate tombstones: %w", err)
+	}
+
+	return issues, nil
+}
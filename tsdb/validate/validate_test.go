@@ -0,0 +1,42 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/tsdb"
+	"github.com/prometheus/prometheus/util/testutil"
+)
+
+func TestBlock_ValidBlockHasNoIssues(t *testing.T) {
+	dir := testutil.NewTemporaryDirectory("test", t)
+	defer dir.Close()
+
+	samples := []*tsdb.MetricSample{
+		{Labels: labels.FromStrings("__name__", "a"), TimestampMs: 0, Value: 1},
+		{Labels: labels.FromStrings("__name__", "a"), TimestampMs: 100, Value: 2},
+		{Labels: labels.FromStrings("__name__", "b"), TimestampMs: 0, Value: 3},
+	}
+
+	blockDir, err := tsdb.CreateBlock(samples, dir.Path(), 0, 200, log.NewNopLogger())
+	testutil.Ok(t, err)
+
+	issues, err := Block(blockDir)
+	testutil.Ok(t, err)
+	testutil.Equals(t, 0, len(issues))
+}
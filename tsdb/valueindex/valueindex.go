@@ -0,0 +1,97 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package valueindex computes and queries an optional per-chunk min/max
+// value side index. A query with a value comparison, e.g. "> 0.9", can
+// consult the index to skip chunks whose value range cannot satisfy the
+// comparison instead of decoding them. The index is kept separate from a
+// block's main index so blocks built without it are unaffected; building it
+// is opt-in, since it adds per-chunk space and write-time cost.
+//
+// This package only computes ranges and answers whether a given range can
+// satisfy a comparison -- wiring it into block writing and query planning is
+// left to the caller.
+package valueindex
+
+import (
+	"math"
+
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/tsdb/chunkenc"
+	"github.com/prometheus/prometheus/tsdb/chunks"
+)
+
+// Range is the value range of a single chunk.
+type Range struct {
+	Ref      uint64
+	Min, Max float64
+}
+
+// Build computes a Range for each of chks by iterating its samples.
+func Build(chks []chunks.Meta) ([]Range, error) {
+	ranges := make([]Range, 0, len(chks))
+	for _, c := range chks {
+		min, max, ok, err := minMax(c.Chunk)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		ranges = append(ranges, Range{Ref: c.Ref, Min: min, Max: max})
+	}
+	return ranges, nil
+}
+
+func minMax(c chunkenc.Chunk) (min, max float64, ok bool, err error) {
+	it := c.Iterator(nil)
+	min, max = math.Inf(1), math.Inf(-1)
+	for it.Next() {
+		_, v := it.At()
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+		ok = true
+	}
+	if it.Err() != nil {
+		return 0, 0, false, it.Err()
+	}
+	return min, max, ok, nil
+}
+
+// CanMatch reports whether a chunk with value range r could hold a sample
+// satisfying "<value> op threshold", e.g. CanMatch(r, parser.GTR, 0.9) for
+// the PromQL filter "> 0.9". A chunk for which it returns false can be
+// skipped entirely; an unrecognized op always returns true, since the chunk
+// cannot be safely ruled out.
+func CanMatch(r Range, op parser.ItemType, threshold float64) bool {
+	switch op {
+	case parser.GTR:
+		return r.Max > threshold
+	case parser.GTE:
+		return r.Max >= threshold
+	case parser.LSS:
+		return r.Min < threshold
+	case parser.LTE:
+		return r.Min <= threshold
+	case parser.EQL:
+		return r.Min <= threshold && threshold <= r.Max
+	case parser.NEQ:
+		return !(r.Min == r.Max && r.Min == threshold)
+	default:
+		return true
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package valueindex
+
+import (
+	"testing"
+
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/tsdb/chunkenc"
+	"github.com/prometheus/prometheus/tsdb/chunks"
+	"github.com/prometheus/prometheus/util/testutil"
+)
+
+func chunkFromSamples(t *testing.T, samples [][2]float64) chunkenc.Chunk {
+	c := chunkenc.NewXORChunk()
+	app, err := c.Appender()
+	testutil.Ok(t, err)
+	for _, s := range samples {
+		app.Append(int64(s[0]), s[1])
+	}
+	return c
+}
+
+func TestBuild(t *testing.T) {
+	chks := []chunks.Meta{
+		{Ref: 1, Chunk: chunkFromSamples(t, [][2]float64{{0, 1}, {1, 5}, {2, -2}})},
+		{Ref: 2, Chunk: chunkFromSamples(t, [][2]float64{{0, 0.5}})},
+	}
+
+	ranges, err := Build(chks)
+	testutil.Ok(t, err)
+	testutil.Equals(t, []Range{
+		{Ref: 1, Min: -2, Max: 5},
+		{Ref: 2, Min: 0.5, Max: 0.5},
+	}, ranges)
+}
+
+func TestCanMatch(t *testing.T) {
+	r := Range{Min: 0, Max: 10}
+
+	cases := []struct {
+		op        parser.ItemType
+		threshold float64
+		want      bool
+	}{
+		{parser.GTR, 10, false},
+		{parser.GTR, 9, true},
+		{parser.GTE, 10, true},
+		{parser.GTE, 10.1, false},
+		{parser.LSS, 0, false},
+		{parser.LSS, 0.1, true},
+		{parser.LTE, 0, true},
+		{parser.LTE, -0.1, false},
+		{parser.EQL, 5, true},
+		{parser.EQL, 11, false},
+		{parser.NEQ, 5, true},
+	}
+	for _, c := range cases {
+		testutil.Equals(t, c.want, CanMatch(r, c.op, c.threshold))
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tsdb
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+	"golang.org/x/time/rate"
+)
+
+// warmupReadBufferSize is the chunk size warmupBlocks reads files in. It is
+// also the rate limiter's burst size, so a single read never blocks for
+// longer than it takes to drain one buffer's worth of the configured
+// bandwidth.
+const warmupReadBufferSize = 1 << 20 // 1MiB
+
+// warmupBlocks sequentially reads through the index and chunk files of the
+// bytesPerSecond most recent blocks (or all of them, if maxBlocks is 0 or
+// less), to pull them into the OS page cache before the first queries after
+// a restart need them. It is throttled to roughly bytesPerSecond, since this
+// runs concurrently with WAL replay and normal query traffic and must not
+// compete with either for disk bandwidth.
+//
+// It stops early if db is closed.
+func (db *DB) warmupBlocks(bytesPerSecond int64, maxBlocks int) {
+	defer db.warmupWG.Done()
+
+	blocks := db.Blocks()
+	if len(blocks) == 0 {
+		return
+	}
+
+	// Warm the most recently written blocks first -- they are the ones
+	// dashboards are overwhelmingly likely to query right after a restart.
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].Meta().MaxTime > blocks[j].Meta().MaxTime
+	})
+	if maxBlocks > 0 && len(blocks) > maxBlocks {
+		blocks = blocks[:maxBlocks]
+	}
+
+	level.Info(db.logger).Log("msg", "Warming up page cache for recent blocks", "blocks", len(blocks), "bytes_per_second", bytesPerSecond)
+	start := time.Now()
+
+	// Cap the burst (and the read size that feeds it) at bytesPerSecond
+	// itself: with the default burst of warmupReadBufferSize, a
+	// bytesPerSecond lower than that would let an entire small warm-up
+	// through on the limiter's initial full bucket without ever blocking.
+	burst := warmupReadBufferSize
+	if bytesPerSecond < int64(burst) {
+		burst = int(bytesPerSecond)
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	limiter := rate.NewLimiter(rate.Limit(bytesPerSecond), burst)
+	buf := make([]byte, burst)
+
+	// Derive a context that is canceled as soon as db.stopc is closed, so a
+	// warm-up blocked inside the rate limiter -- where it spends nearly all
+	// of its time -- unblocks immediately on Close instead of only being
+	// checked between reads.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-db.stopc:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for _, b := range blocks {
+		if err := warmupDir(ctx, limiter, buf, b.Dir()); err != nil {
+			level.Warn(db.logger).Log("msg", "Block warm-up stopped early", "block", b.Meta().ULID, "err", err)
+			return
+		}
+	}
+
+	level.Info(db.logger).Log("msg", "Finished warming up page cache for recent blocks", "duration", time.Since(start))
+}
+
+// warmupDir reads every regular file under dir, rate limited by limiter,
+// discarding the contents. It returns early, with an error, if ctx is
+// canceled.
+func warmupDir(ctx context.Context, limiter *rate.Limiter, buf []byte, dir string) error {
+	return filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			return nil
+		}
+		return warmupFile(ctx, limiter, buf, path)
+	})
+}
+
+func warmupFile(ctx context.Context, limiter *rate.Limiter, buf []byte, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		// The file may have been deleted by a compaction or retention
+		// cleanup started after db.Blocks() was read; skip it.
+		return nil
+	}
+	defer f.Close()
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		n, err := f.Read(buf)
+		if n > 0 {
+			// WaitN blocks for most of a rate-limited warm-up's wall time,
+			// so it -- not the ctx.Err check above -- is what needs to
+			// observe ctx's cancellation promptly.
+			if werr := limiter.WaitN(ctx, n); werr != nil {
+				return werr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return nil
+		}
+	}
+}
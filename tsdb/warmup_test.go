@@ -0,0 +1,96 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tsdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/util/testutil"
+)
+
+func TestWarmupBlocks(t *testing.T) {
+	db, closeFn := openTestDB(t, nil, nil)
+	defer func() {
+		testutil.Ok(t, db.Close())
+		closeFn()
+	}()
+
+	metas := []BlockMeta{
+		{MinTime: 0, MaxTime: 100},
+		{MinTime: 100, MaxTime: 200},
+		{MinTime: 200, MaxTime: 300},
+	}
+	for _, m := range metas {
+		createBlock(t, db.Dir(), genSeries(10, 10, m.MinTime, m.MaxTime))
+	}
+	testutil.Ok(t, db.reload())
+	testutil.Equals(t, 3, len(db.Blocks()))
+
+	// A generous bandwidth should read through every block without error.
+	db.warmupWG.Add(1)
+	db.warmupBlocks(1<<30, 0)
+
+	// maxBlocks caps how many of the most recent blocks are touched; this
+	// mostly just exercises the cap without a way to observe the page
+	// cache directly.
+	db.warmupWG.Add(1)
+	db.warmupBlocks(1<<30, 1)
+}
+
+func TestWarmupBlocksStopsOnClose(t *testing.T) {
+	db, closeFn := openTestDB(t, nil, nil)
+	defer closeFn()
+
+	createBlock(t, db.Dir(), genSeries(10, 10, 0, 100))
+	testutil.Ok(t, db.reload())
+
+	testutil.Ok(t, db.Close())
+
+	// Warming up after Close should stop immediately via the already-closed
+	// stopc rather than hang or panic.
+	db.warmupWG.Add(1)
+	db.warmupBlocks(1, 0)
+}
+
+// TestWarmupBlocksStopsWhileRateLimited checks that Close interrupts a
+// warm-up that is blocked inside the rate limiter -- where it spends nearly
+// all of its time -- rather than only being noticed between reads or files.
+func TestWarmupBlocksStopsWhileRateLimited(t *testing.T) {
+	db, closeFn := openTestDB(t, nil, nil)
+	defer closeFn()
+
+	// A big block and a tiny bandwidth guarantee warmupBlocks is still
+	// waiting on the limiter, nowhere near done, when Close runs.
+	createBlock(t, db.Dir(), genSeries(100, 100, 0, 1000))
+	testutil.Ok(t, db.reload())
+
+	db.warmupWG.Add(1)
+	go db.warmupBlocks(1, 0)
+
+	// Give warmupBlocks a moment to start and block inside the limiter.
+	time.Sleep(10 * time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		testutil.Ok(t, db.Close())
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close did not return promptly while warmupBlocks was rate limited")
+	}
+}
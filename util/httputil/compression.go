@@ -14,6 +14,7 @@
 package httputil
 
 import (
+	"bytes"
 	"compress/gzip"
 	"compress/zlib"
 	"io"
@@ -32,16 +33,88 @@ const (
 // on the client's Accept-Encoding headers.
 type compressedResponseWriter struct {
 	http.ResponseWriter
+
+	// encoding is the negotiated Content-Encoding, or "" if the client
+	// does not accept one we support, in which case writes pass straight
+	// through. minSize and level only matter when encoding is set.
+	encoding string
+	minSize  int
+	level    int
+
+	statusCode int
+	headerSet  bool
+
+	buf    bytes.Buffer
 	writer io.Writer
 }
 
-// Writes HTTP response content data.
+// WriteHeader implements http.ResponseWriter. It is buffered, like the
+// response body, until it is known whether the response will be compressed.
+func (c *compressedResponseWriter) WriteHeader(code int) {
+	if c.writer != nil {
+		c.ResponseWriter.WriteHeader(code)
+		return
+	}
+	c.statusCode = code
+	c.headerSet = true
+}
+
+// Write implements http.ResponseWriter.
 func (c *compressedResponseWriter) Write(p []byte) (int, error) {
-	return c.writer.Write(p)
+	if c.writer != nil {
+		return c.writer.Write(p)
+	}
+	n, err := c.buf.Write(p)
+	if err != nil {
+		return n, err
+	}
+	if c.buf.Len() >= c.minSize {
+		err = c.flush(true)
+	}
+	return n, err
+}
+
+// flush decides the response is complete enough to send: compress, if
+// compress is true and the client accepts one of our encodings, otherwise
+// send the buffered body as plain text. Either way it is only called once.
+func (c *compressedResponseWriter) flush(compress bool) error {
+	if compress && c.encoding != "" {
+		c.ResponseWriter.Header().Set(contentEncodingHeader, c.encoding)
+	}
+	if c.headerSet {
+		c.ResponseWriter.WriteHeader(c.statusCode)
+	}
+
+	switch {
+	case compress && c.encoding == gzipEncoding:
+		w, err := gzip.NewWriterLevel(c.ResponseWriter, c.level)
+		if err != nil {
+			return err
+		}
+		c.writer = w
+	case compress && c.encoding == deflateEncoding:
+		w, err := zlib.NewWriterLevel(c.ResponseWriter, c.level)
+		if err != nil {
+			return err
+		}
+		c.writer = w
+	default:
+		c.writer = c.ResponseWriter
+	}
+	_, err := c.writer.Write(c.buf.Bytes())
+	c.buf.Reset()
+	return err
 }
 
-// Closes the compressedResponseWriter and ensures to flush all data before.
+// Close ensures the response is flushed, compressing it first if that
+// decision hasn't already been made because the body never reached minSize.
 func (c *compressedResponseWriter) Close() {
+	if c.writer == nil {
+		// The body never reached minSize, so it isn't worth compressing.
+		if err := c.flush(false); err != nil {
+			return
+		}
+	}
 	if zlibWriter, ok := c.writer.(*zlib.Writer); ok {
 		zlibWriter.Flush()
 	}
@@ -54,21 +127,16 @@ func (c *compressedResponseWriter) Close() {
 }
 
 // Constructs a new compressedResponseWriter based on client request headers.
-func newCompressedResponseWriter(writer http.ResponseWriter, req *http.Request) *compressedResponseWriter {
+func newCompressedResponseWriter(writer http.ResponseWriter, req *http.Request, minSize, level int) *compressedResponseWriter {
 	encodings := strings.Split(req.Header.Get(acceptEncodingHeader), ",")
 	for _, encoding := range encodings {
 		switch strings.TrimSpace(encoding) {
-		case gzipEncoding:
-			writer.Header().Set(contentEncodingHeader, gzipEncoding)
-			return &compressedResponseWriter{
-				ResponseWriter: writer,
-				writer:         gzip.NewWriter(writer),
-			}
-		case deflateEncoding:
-			writer.Header().Set(contentEncodingHeader, deflateEncoding)
+		case gzipEncoding, deflateEncoding:
 			return &compressedResponseWriter{
 				ResponseWriter: writer,
-				writer:         zlib.NewWriter(writer),
+				encoding:       strings.TrimSpace(encoding),
+				minSize:        minSize,
+				level:          level,
 			}
 		}
 	}
@@ -80,13 +148,24 @@ func newCompressedResponseWriter(writer http.ResponseWriter, req *http.Request)
 
 // CompressionHandler is a wrapper around http.Handler which adds suitable
 // response compression based on the client's Accept-Encoding headers.
+//
+// MinSize is the smallest response body, in bytes, worth compressing; 0
+// compresses every response, which is appropriate for handlers that always
+// produce sizable output. Level is the compression level to use, as defined
+// by compress/gzip and compress/zlib; 0 uses their default.
 type CompressionHandler struct {
 	Handler http.Handler
+	MinSize int
+	Level   int
 }
 
 // ServeHTTP adds compression to the original http.Handler's ServeHTTP() method.
 func (c CompressionHandler) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
-	compWriter := newCompressedResponseWriter(writer, req)
+	level := c.Level
+	if level == 0 {
+		level = gzip.DefaultCompression
+	}
+	compWriter := newCompressedResponseWriter(writer, req, c.MinSize, level)
 	c.Handler.ServeHTTP(compWriter, req)
 	compWriter.Close()
 }
@@ -125,6 +125,46 @@ func TestCompressionHandler_Gzip(t *testing.T) {
 	}
 }
 
+func TestCompressionHandler_MinSize(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	ch := CompressionHandler{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("short"))
+		}),
+		MinSize: 1024,
+	}
+	mux.Handle("/foo_endpoint", ch)
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			DisableCompression: true,
+		},
+	}
+
+	req, _ := http.NewRequest("GET", server.URL+"/foo_endpoint", nil)
+	req.Header.Set(acceptEncodingHeader, gzipEncoding)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Error("client get failed with unexpected error")
+	}
+	defer resp.Body.Close()
+
+	if actual := resp.Header.Get(contentEncodingHeader); actual != "" {
+		t.Errorf("expected no Content-Encoding for a response below MinSize, but got %s", actual)
+	}
+
+	contents, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Errorf("unexpected error while reading the response body: %s", err.Error())
+	}
+	if actual := string(contents); actual != "short" {
+		t.Errorf("expected response with content %q, but got %q", "short", actual)
+	}
+}
+
 func TestCompressionHandler_Deflate(t *testing.T) {
 	tearDown := setup()
 	defer tearDown()
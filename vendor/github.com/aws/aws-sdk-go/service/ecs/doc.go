@@ -0,0 +1,45 @@
+// Code generated by private/model/cli/gen-api/main.go. DO NOT EDIT.
+
+// Package ecs provides the client and types for making API
+// requests to Amazon EC2 Container Service.
+//
+// Amazon Elastic Container Service (Amazon ECS) is a highly scalable, fast,
+// container management service that makes it easy to run, stop, and manage
+// Docker containers on a cluster. You can host your cluster on a serverless
+// infrastructure that is managed by Amazon ECS by launching your services or
+// tasks using the Fargate launch type. For more control, you can host your
+// tasks on a cluster of Amazon Elastic Compute Cloud (Amazon EC2) instances
+// that you manage by using the EC2 launch type. For more information about
+// launch types, see Amazon ECS Launch Types (https://docs.aws.amazon.com/AmazonECS/latest/developerguide/launch_types.html).
+//
+// Amazon ECS lets you launch and stop container-based applications with simple
+// API calls, allows you to get the state of your cluster from a centralized
+// service, and gives you access to many familiar Amazon EC2 features.
+//
+// You can use Amazon ECS to schedule the placement of containers across your
+// cluster based on your resource needs, isolation policies, and availability
+// requirements. Amazon ECS eliminates the need for you to operate your own
+// cluster management and configuration management systems or worry about scaling
+// your management infrastructure.
+//
+// See https://docs.aws.amazon.com/goto/WebAPI/ecs-2014-11-13 for more information on this service.
+//
+// See ecs package documentation for more information.
+// https://docs.aws.amazon.com/sdk-for-go/api/service/ecs/
+//
+// Using the Client
+//
+// To contact Amazon EC2 Container Service with the SDK use the New function to create
+// a new service client. With that client you can make API requests to the service.
+// These clients are safe to use concurrently.
+//
+// See the SDK's documentation for more information on how to use the SDK.
+// https://docs.aws.amazon.com/sdk-for-go/api/
+//
+// See aws.Config documentation for more information on configuring SDK clients.
+// https://docs.aws.amazon.com/sdk-for-go/api/aws/#Config
+//
+// See the Amazon EC2 Container Service client ECS for more
+// information on creating client for this service.
+// https://docs.aws.amazon.com/sdk-for-go/api/service/ecs/#New
+package ecs
@@ -34,10 +34,12 @@ import (
 	"github.com/go-kit/kit/log/level"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/pkg/errors"
+	"github.com/prometheus/alertmanager/api/v2/models"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/common/route"
 	"github.com/prometheus/prometheus/config"
+	"github.com/prometheus/prometheus/notifier"
 	"github.com/prometheus/prometheus/pkg/gate"
 	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/pkg/textparse"
@@ -99,16 +101,20 @@ func (e *apiError) Error() string {
 	return fmt.Sprintf("%s: %s", e.typ, e.err)
 }
 
-// TargetRetriever provides the list of active/dropped targets to scrape or not.
+// TargetRetriever provides the list of active/dropped targets to scrape or
+// not, and lets callers pause or resume scraping a named scrape pool.
 type TargetRetriever interface {
 	TargetsActive() map[string][]*scrape.Target
 	TargetsDropped() map[string][]*scrape.Target
+	PauseScrapePool(name string) error
+	ResumeScrapePool(name string) error
 }
 
 // AlertmanagerRetriever provides a list of all/dropped AlertManager URLs.
 type AlertmanagerRetriever interface {
 	Alertmanagers() []*url.URL
 	DroppedAlertmanagers() []*url.URL
+	Silences(ctx context.Context) ([]*models.GettableSilence, error)
 }
 
 // RulesRetriever provides a list of active rules and alerts.
@@ -185,16 +191,18 @@ type API struct {
 	ready                 func(http.HandlerFunc) http.HandlerFunc
 	globalURLOptions      GlobalURLOptions
 
-	db                        TSDBAdminStats
-	dbDir                     string
-	enableAdmin               bool
-	logger                    log.Logger
-	remoteReadSampleLimit     int
-	remoteReadMaxBytesInFrame int
-	remoteReadGate            *gate.Gate
-	CORSOrigin                *regexp.Regexp
-	buildInfo                 *PrometheusVersion
-	runtimeInfo               func() (RuntimeInfo, error)
+	db                           TSDBAdminStats
+	dbDir                        string
+	enableAdmin                  bool
+	logger                       log.Logger
+	remoteReadSampleLimit        int
+	remoteReadMaxBytesInFrame    int
+	remoteReadMaxBytesInResponse int
+	remoteReadGate               *gate.Gate
+	CORSOrigin                   *regexp.Regexp
+	buildInfo                    *PrometheusVersion
+	runtimeInfo                  func() (RuntimeInfo, error)
+	externalURL                  *url.URL
 }
 
 func init() {
@@ -220,9 +228,11 @@ func NewAPI(
 	remoteReadSampleLimit int,
 	remoteReadConcurrencyLimit int,
 	remoteReadMaxBytesInFrame int,
+	remoteReadMaxBytesInResponse int,
 	CORSOrigin *regexp.Regexp,
 	runtimeInfo func() (RuntimeInfo, error),
 	buildInfo *PrometheusVersion,
+	externalURL *url.URL,
 ) *API {
 	return &API{
 		QueryEngine:           qe,
@@ -230,22 +240,24 @@ func NewAPI(
 		targetRetriever:       tr,
 		alertmanagerRetriever: ar,
 
-		now:                       time.Now,
-		config:                    configFunc,
-		flagsMap:                  flagsMap,
-		ready:                     readyFunc,
-		globalURLOptions:          globalURLOptions,
-		db:                        db,
-		dbDir:                     dbDir,
-		enableAdmin:               enableAdmin,
-		rulesRetriever:            rr,
-		remoteReadSampleLimit:     remoteReadSampleLimit,
-		remoteReadGate:            gate.New(remoteReadConcurrencyLimit),
-		remoteReadMaxBytesInFrame: remoteReadMaxBytesInFrame,
-		logger:                    logger,
-		CORSOrigin:                CORSOrigin,
-		runtimeInfo:               runtimeInfo,
-		buildInfo:                 buildInfo,
+		now:                          time.Now,
+		config:                       configFunc,
+		flagsMap:                     flagsMap,
+		ready:                        readyFunc,
+		globalURLOptions:             globalURLOptions,
+		db:                           db,
+		dbDir:                        dbDir,
+		enableAdmin:                  enableAdmin,
+		rulesRetriever:               rr,
+		remoteReadSampleLimit:        remoteReadSampleLimit,
+		remoteReadGate:               gate.New(remoteReadConcurrencyLimit),
+		remoteReadMaxBytesInFrame:    remoteReadMaxBytesInFrame,
+		remoteReadMaxBytesInResponse: remoteReadMaxBytesInResponse,
+		logger:                       logger,
+		CORSOrigin:                   CORSOrigin,
+		runtimeInfo:                  runtimeInfo,
+		buildInfo:                    buildInfo,
+		externalURL:                  externalURL,
 	}
 }
 
@@ -311,6 +323,8 @@ func (api *API) Register(r *route.Router) {
 
 	r.Get("/alerts", wrap(api.alerts))
 	r.Get("/rules", wrap(api.rules))
+	r.Get("/rules/test", wrap(api.ruleTest))
+	r.Post("/rules/test", wrap(api.ruleTest))
 
 	// Admin APIs
 	r.Post("/admin/tsdb/delete_series", wrap(api.deleteSeries))
@@ -321,12 +335,18 @@ func (api *API) Register(r *route.Router) {
 	r.Put("/admin/tsdb/clean_tombstones", wrap(api.cleanTombstones))
 	r.Put("/admin/tsdb/snapshot", wrap(api.snapshot))
 
+	r.Post("/admin/scrape_pools/:pool/pause", wrap(api.pauseScrapePool))
+	r.Post("/admin/scrape_pools/:pool/resume", wrap(api.resumeScrapePool))
+
+	r.Put("/admin/scrape_pools/:pool/pause", wrap(api.pauseScrapePool))
+	r.Put("/admin/scrape_pools/:pool/resume", wrap(api.resumeScrapePool))
 }
 
 type queryData struct {
-	ResultType parser.ValueType  `json:"resultType"`
-	Result     parser.Value      `json:"result"`
-	Stats      *stats.QueryStats `json:"stats,omitempty"`
+	ResultType parser.ValueType          `json:"resultType"`
+	Result     parser.Value              `json:"result"`
+	Stats      *stats.QueryStats         `json:"stats,omitempty"`
+	Analysis   *promql.AnalyzeOutputNode `json:"analysis,omitempty"`
 }
 
 func (api *API) options(r *http.Request) apiFuncResult {
@@ -351,6 +371,16 @@ func (api *API) query(r *http.Request) (result apiFuncResult) {
 		defer cancel()
 	}
 
+	opts := r.FormValue("stats")
+	if opts == "all" {
+		ctx = promql.NewAnalyzeContext(ctx)
+	}
+
+	ctx, err = applyReadConsistency(ctx, r)
+	if err != nil {
+		return apiFuncResult{nil, &apiError{errorBadData, err}, nil, nil}
+	}
+
 	qry, err := api.QueryEngine.NewInstantQuery(api.Queryable, r.FormValue("query"), ts)
 	if err != nil {
 		err = errors.Wrapf(err, "invalid parameter 'query'")
@@ -374,7 +404,7 @@ func (api *API) query(r *http.Request) (result apiFuncResult) {
 
 	// Optional stats field in response if parameter "stats" is not empty.
 	var qs *stats.QueryStats
-	if r.FormValue("stats") != "" {
+	if opts != "" {
 		qs = stats.NewQueryStats(qry.Stats())
 	}
 
@@ -382,6 +412,7 @@ func (api *API) query(r *http.Request) (result apiFuncResult) {
 		ResultType: res.Value.Type(),
 		Result:     res.Value,
 		Stats:      qs,
+		Analysis:   qry.Analyze(),
 	}, nil, res.Warnings, qry.Close}
 }
 
@@ -432,6 +463,16 @@ func (api *API) queryRange(r *http.Request) (result apiFuncResult) {
 		defer cancel()
 	}
 
+	opts := r.FormValue("stats")
+	if opts == "all" {
+		ctx = promql.NewAnalyzeContext(ctx)
+	}
+
+	ctx, err = applyReadConsistency(ctx, r)
+	if err != nil {
+		return apiFuncResult{nil, &apiError{errorBadData, err}, nil, nil}
+	}
+
 	qry, err := api.QueryEngine.NewRangeQuery(api.Queryable, r.FormValue("query"), start, end, step)
 	if err != nil {
 		return apiFuncResult{nil, &apiError{errorBadData, err}, nil, nil}
@@ -454,7 +495,7 @@ func (api *API) queryRange(r *http.Request) (result apiFuncResult) {
 
 	// Optional stats field in response if parameter "stats" is not empty.
 	var qs *stats.QueryStats
-	if r.FormValue("stats") != "" {
+	if opts != "" {
 		qs = stats.NewQueryStats(qry.Stats())
 	}
 
@@ -462,9 +503,27 @@ func (api *API) queryRange(r *http.Request) (result apiFuncResult) {
 		ResultType: res.Value.Type(),
 		Result:     res.Value,
 		Stats:      qs,
+		Analysis:   qry.Analyze(),
 	}, nil, res.Warnings, qry.Close}
 }
 
+// applyReadConsistency attaches the read consistency requested by the
+// "consistency" URL parameter to ctx, so that a fanout Storage's
+// Querier/ChunkQuerier can restrict itself to the primary ("local") or
+// secondary ("remote") storages for this query. An empty or missing
+// parameter leaves ctx untouched, which defaults to querying and merging
+// everything.
+func applyReadConsistency(ctx context.Context, r *http.Request) (context.Context, error) {
+	switch c := storage.ReadConsistency(r.FormValue("consistency")); c {
+	case "":
+		return ctx, nil
+	case storage.ReadConsistencyAll, storage.ReadConsistencyLocal, storage.ReadConsistencyRemote:
+		return storage.NewReadConsistencyContext(ctx, c), nil
+	default:
+		return ctx, errors.Errorf("invalid parameter 'consistency': %q", c)
+	}
+}
+
 func returnAPIError(err error) *apiError {
 	if err == nil {
 		return nil
@@ -890,16 +949,24 @@ type Alert struct {
 	State       string        `json:"state"`
 	ActiveAt    *time.Time    `json:"activeAt,omitempty"`
 	Value       string        `json:"value"`
+	// Silenced is true if the alert is currently covered by an active
+	// silence on one of the configured Alertmanagers.
+	Silenced bool `json:"silenced"`
 }
 
 func (api *API) alerts(r *http.Request) apiFuncResult {
 	alertingRules := api.rulesRetriever(r.Context()).AlertingRules()
 	alerts := []*Alert{}
 
+	silences, err := api.alertmanagerRetriever(r.Context()).Silences(r.Context())
+	if err != nil {
+		level.Warn(api.logger).Log("msg", "Error fetching silences to annotate alerts as silenced", "err", err)
+	}
+
 	for _, alertingRule := range alertingRules {
 		alerts = append(
 			alerts,
-			rulesAlertsToAPIAlerts(alertingRule.ActiveAlerts())...,
+			rulesAlertsToAPIAlerts(alertingRule.ActiveAlerts(), silences)...,
 		)
 	}
 
@@ -908,7 +975,7 @@ func (api *API) alerts(r *http.Request) apiFuncResult {
 	return apiFuncResult{res, nil, nil, nil}
 }
 
-func rulesAlertsToAPIAlerts(rulesAlerts []*rules.Alert) []*Alert {
+func rulesAlertsToAPIAlerts(rulesAlerts []*rules.Alert, silences []*models.GettableSilence) []*Alert {
 	apiAlerts := make([]*Alert, len(rulesAlerts))
 	for i, ruleAlert := range rulesAlerts {
 		apiAlerts[i] = &Alert{
@@ -917,12 +984,22 @@ func rulesAlertsToAPIAlerts(rulesAlerts []*rules.Alert) []*Alert {
 			State:       ruleAlert.State.String(),
 			ActiveAt:    &ruleAlert.ActiveAt,
 			Value:       strconv.FormatFloat(ruleAlert.Value, 'e', -1, 64),
+			Silenced:    isSilenced(ruleAlert.Labels, silences),
 		}
 	}
 
 	return apiAlerts
 }
 
+func isSilenced(lset labels.Labels, silences []*models.GettableSilence) bool {
+	for _, s := range silences {
+		if notifier.MatchesSilence(lset, s) {
+			return true
+		}
+	}
+	return false
+}
+
 type metadata struct {
 	Type textparse.MetricType `json:"type"`
 	Help string               `json:"help"`
@@ -1006,6 +1083,9 @@ type RuleGroup struct {
 	Interval       float64   `json:"interval"`
 	EvaluationTime float64   `json:"evaluationTime"`
 	LastEvaluation time.Time `json:"lastEvaluation"`
+	// DryRun reports whether the group's rules are evaluated without being
+	// recorded or triggering alert notifications.
+	DryRun bool `json:"dryRun"`
 }
 
 type rule interface{}
@@ -1052,6 +1132,15 @@ func (api *API) rules(r *http.Request) apiFuncResult {
 	returnAlerts := typeParam == "" || typeParam == "alert"
 	returnRecording := typeParam == "" || typeParam == "record"
 
+	var silences []*models.GettableSilence
+	if returnAlerts {
+		var err error
+		silences, err = api.alertmanagerRetriever(r.Context()).Silences(r.Context())
+		if err != nil {
+			level.Warn(api.logger).Log("msg", "Error fetching silences to annotate alerts as silenced", "err", err)
+		}
+	}
+
 	for i, grp := range ruleGroups {
 		apiRuleGroup := &RuleGroup{
 			Name:           grp.Name(),
@@ -1060,6 +1149,7 @@ func (api *API) rules(r *http.Request) apiFuncResult {
 			Rules:          []rule{},
 			EvaluationTime: grp.GetEvaluationDuration().Seconds(),
 			LastEvaluation: grp.GetEvaluationTimestamp(),
+			DryRun:         grp.DryRun(),
 		}
 		for _, r := range grp.Rules() {
 			var enrichedRule rule
@@ -1080,7 +1170,7 @@ func (api *API) rules(r *http.Request) apiFuncResult {
 					Duration:       rule.HoldDuration().Seconds(),
 					Labels:         rule.Labels(),
 					Annotations:    rule.Annotations(),
-					Alerts:         rulesAlertsToAPIAlerts(rule.ActiveAlerts()),
+					Alerts:         rulesAlertsToAPIAlerts(rule.ActiveAlerts(), silences),
 					Health:         rule.Health(),
 					LastError:      lastError,
 					EvaluationTime: rule.GetEvaluationDuration().Seconds(),
@@ -1114,6 +1204,140 @@ func (api *API) rules(r *http.Request) apiFuncResult {
 	return apiFuncResult{res, nil, nil, nil}
 }
 
+// ruleTestAlert is the rendered preview of a single would-be alert produced
+// by a dry-run rule evaluation.
+type ruleTestAlert struct {
+	State       string        `json:"state"`
+	Labels      labels.Labels `json:"labels"`
+	Annotations labels.Labels `json:"annotations"`
+	Value       string        `json:"value"`
+}
+
+// ruleTestResult is the response of a dry-run rule evaluation.
+type ruleTestResult struct {
+	Alerts []ruleTestAlert `json:"alerts"`
+}
+
+// ruleTest evaluates an alerting rule against live data right now and
+// returns the fully rendered labels and annotations for each resulting
+// alert, without recording anything or notifying Alertmanager. This lets a
+// rule's label and annotation templates be checked for errors on demand,
+// rather than only when the rule happens to fire for real.
+//
+// The rule under test can either be given inline via the 'expr' (and
+// optional 'for', 'label' and 'annotation') parameters, or referenced by an
+// existing rule's 'group' and 'rule' name; in the latter case the live
+// rule's own definition is used and its state is left untouched.
+func (api *API) ruleTest(r *http.Request) apiFuncResult {
+	ts, err := parseTimeParam(r, "time", api.now())
+	if err != nil {
+		return apiFuncResult{nil, &apiError{errorBadData, err}, nil, nil}
+	}
+
+	groupName, ruleName, exprParam := r.FormValue("group"), r.FormValue("rule"), r.FormValue("expr")
+
+	var (
+		name                   string
+		expr                   parser.Expr
+		hold                   time.Duration
+		ruleLabels, ruleAnnots labels.Labels
+	)
+
+	switch {
+	case exprParam != "":
+		name = ruleName
+		if name == "" {
+			name = "test"
+		}
+		if expr, err = parser.ParseExpr(exprParam); err != nil {
+			return apiFuncResult{nil, &apiError{errorBadData, errors.Wrap(err, "parsing expr")}, nil, nil}
+		}
+		if s := r.FormValue("for"); s != "" {
+			if hold, err = parseDuration(s); err != nil {
+				return apiFuncResult{nil, &apiError{errorBadData, err}, nil, nil}
+			}
+		}
+		if ruleLabels, err = parseLabelParams(r, "label"); err != nil {
+			return apiFuncResult{nil, &apiError{errorBadData, err}, nil, nil}
+		}
+		if ruleAnnots, err = parseLabelParams(r, "annotation"); err != nil {
+			return apiFuncResult{nil, &apiError{errorBadData, err}, nil, nil}
+		}
+
+	case groupName != "" && ruleName != "":
+		existing, apiErr := api.findAlertingRule(r.Context(), groupName, ruleName)
+		if apiErr != nil {
+			return apiFuncResult{nil, apiErr, nil, nil}
+		}
+		name, expr, hold = existing.Name(), existing.Query(), existing.HoldDuration()
+		ruleLabels, ruleAnnots = existing.Labels(), existing.Annotations()
+
+	default:
+		err := errors.New("either 'expr' or both 'group' and 'rule' must be provided")
+		return apiFuncResult{nil, &apiError{errorBadData, err}, nil, nil}
+	}
+
+	externalLabels := api.config().GlobalConfig.ExternalLabels
+	testRule := rules.NewAlertingRule(name, expr, hold, ruleLabels, ruleAnnots, externalLabels, true, log.NewNopLogger())
+
+	queryFunc := rules.EngineQueryFunc(api.QueryEngine, api.Queryable)
+	if _, err := testRule.Eval(r.Context(), ts, queryFunc, api.externalURL); err != nil {
+		return apiFuncResult{nil, &apiError{errorExec, err}, nil, nil}
+	}
+
+	active := testRule.ActiveAlerts()
+	res := ruleTestResult{Alerts: make([]ruleTestAlert, 0, len(active))}
+	for _, a := range active {
+		res.Alerts = append(res.Alerts, ruleTestAlert{
+			State:       a.State.String(),
+			Labels:      a.Labels,
+			Annotations: a.Annotations,
+			Value:       strconv.FormatFloat(a.Value, 'e', -1, 64),
+		})
+	}
+
+	return apiFuncResult{res, nil, nil, nil}
+}
+
+// findAlertingRule looks up the alerting rule named ruleName in the rule
+// group named groupName.
+func (api *API) findAlertingRule(ctx context.Context, groupName, ruleName string) (*rules.AlertingRule, *apiError) {
+	for _, grp := range api.rulesRetriever(ctx).RuleGroups() {
+		if grp.Name() != groupName {
+			continue
+		}
+		for _, r := range grp.Rules() {
+			if ar, ok := r.(*rules.AlertingRule); ok && ar.Name() == ruleName {
+				return ar, nil
+			}
+		}
+		return nil, &apiError{errorNotFound, errors.Errorf("no alerting rule named %q in group %q", ruleName, groupName)}
+	}
+	return nil, &apiError{errorNotFound, errors.Errorf("no rule group named %q", groupName)}
+}
+
+// parseLabelParams parses repeated "name=value" query/form parameters named
+// paramName into a sorted label set.
+func parseLabelParams(r *http.Request, paramName string) (labels.Labels, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+	vals := r.Form[paramName]
+	if len(vals) == 0 {
+		return nil, nil
+	}
+
+	lb := labels.NewBuilder(nil)
+	for _, v := range vals {
+		i := strings.Index(v, "=")
+		if i < 0 {
+			return nil, errors.Errorf("invalid %s %q, expected name=value", paramName, v)
+		}
+		lb.Set(v[:i], v[i+1:])
+	}
+	return lb.Labels(), nil
+}
+
 type prometheusConfig struct {
 	YAML string `json:"yaml"`
 }
@@ -1180,8 +1404,13 @@ func (api *API) serveTSDBStatus(*http.Request) apiFuncResult {
 
 func (api *API) remoteRead(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	if err := api.remoteReadGate.Start(ctx); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	if !api.remoteReadGate.TryAcquire() {
+		// Fail fast rather than queue behind already-running reads, so that a
+		// downstream reader that is firing off more concurrent requests than
+		// this server can keep up with can't build up unbounded goroutines
+		// and in-flight query memory by waiting.
+		w.Header().Set("Retry-After", "1")
+		http.Error(w, "too many concurrent remote read queries", http.StatusTooManyRequests)
 		return
 	}
 	remoteReadQueries.Inc()
@@ -1261,7 +1490,7 @@ func (api *API) remoteReadSamples(ctx context.Context, w http.ResponseWriter, re
 			}
 
 			var ws storage.Warnings
-			resp.Results[i], ws, err = remote.ToQueryResult(querier.Select(false, hints, filteredMatchers...), api.remoteReadSampleLimit)
+			resp.Results[i], ws, err = remote.ToQueryResult(querier.Select(false, hints, filteredMatchers...), api.remoteReadSampleLimit, api.remoteReadMaxBytesInResponse)
 			if err != nil {
 				return err
 			}
@@ -1462,6 +1691,39 @@ func (api *API) cleanTombstones(r *http.Request) apiFuncResult {
 	return apiFuncResult{nil, nil, nil, nil}
 }
 
+func (api *API) setScrapePoolPaused(r *http.Request, paused bool) apiFuncResult {
+	if !api.enableAdmin {
+		return apiFuncResult{nil, &apiError{errorUnavailable, errors.New("admin APIs disabled")}, nil, nil}
+	}
+	pool := route.Param(r.Context(), "pool")
+
+	var err error
+	if paused {
+		err = api.targetRetriever(r.Context()).PauseScrapePool(pool)
+	} else {
+		err = api.targetRetriever(r.Context()).ResumeScrapePool(pool)
+	}
+	if err != nil {
+		if err == scrape.ErrScrapePoolNotFound {
+			return apiFuncResult{nil, &apiError{errorNotFound, err}, nil, nil}
+		}
+		return apiFuncResult{nil, &apiError{errorInternal, err}, nil, nil}
+	}
+
+	return apiFuncResult{nil, nil, nil, nil}
+}
+
+// pauseScrapePool stops the named scrape pool from scraping its targets
+// until resumeScrapePool is called or its job is removed from the config.
+func (api *API) pauseScrapePool(r *http.Request) apiFuncResult {
+	return api.setScrapePoolPaused(r, true)
+}
+
+// resumeScrapePool resumes scraping for a scrape pool paused by pauseScrapePool.
+func (api *API) resumeScrapePool(r *http.Request) apiFuncResult {
+	return api.setScrapePoolPaused(r, false)
+}
+
 func (api *API) respond(w http.ResponseWriter, data interface{}, warnings storage.Warnings) {
 	statusMessage := statusSuccess
 	var warningStrings []string
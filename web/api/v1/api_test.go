@@ -34,6 +34,7 @@ import (
 	"github.com/go-kit/kit/log"
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/snappy"
+	"github.com/prometheus/alertmanager/api/v2/models"
 	"github.com/prometheus/client_golang/prometheus"
 	config_util "github.com/prometheus/common/config"
 	"github.com/prometheus/common/model"
@@ -141,6 +142,20 @@ func (t testTargetRetriever) TargetsDropped() map[string][]*scrape.Target {
 	return t.droppedTargets
 }
 
+func (t testTargetRetriever) PauseScrapePool(name string) error {
+	if _, ok := t.activeTargets[name]; !ok {
+		return scrape.ErrScrapePoolNotFound
+	}
+	return nil
+}
+
+func (t testTargetRetriever) ResumeScrapePool(name string) error {
+	if _, ok := t.activeTargets[name]; !ok {
+		return scrape.ErrScrapePoolNotFound
+	}
+	return nil
+}
+
 func (t *testTargetRetriever) SetMetadataStoreForTargets(identifier string, metadata scrape.MetricMetadataStore) error {
 	targets, ok := t.activeTargets[identifier]
 
@@ -189,6 +204,10 @@ func (t testAlertmanagerRetriever) DroppedAlertmanagers() []*url.URL {
 	}
 }
 
+func (t testAlertmanagerRetriever) Silences(ctx context.Context) ([]*models.GettableSilence, error) {
+	return nil, nil
+}
+
 func (t testAlertmanagerRetriever) toFactory() func(context.Context) AlertmanagerRetriever {
 	return func(context.Context) AlertmanagerRetriever { return t }
 }
@@ -516,7 +535,7 @@ func setupRemote(s storage.Storage) *httptest.Server {
 			defer querier.Close()
 
 			set := querier.Select(false, hints, matchers...)
-			resp.Results[i], _, err = remote.ToQueryResult(set, 1e6)
+			resp.Results[i], _, err = remote.ToQueryResult(set, 1e6, 0)
 			if err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 				return
@@ -657,6 +676,31 @@ func testEndpoints(t *testing.T, api *API, tr *testTargetRetriever, testLabelAPI
 			},
 			errType: errorBadData,
 		},
+		{
+			endpoint: api.query,
+			query: url.Values{
+				"query":       []string{"0.333"},
+				"time":        []string{"1970-01-01T00:02:03Z"},
+				"consistency": []string{"local"},
+			},
+			response: &queryData{
+				ResultType: parser.ValueTypeScalar,
+				Result: promql.Scalar{
+					V: 0.333,
+					T: timestamp.FromTime(start.Add(123 * time.Second)),
+				},
+			},
+		},
+		// Invalid consistency value.
+		{
+			endpoint: api.query,
+			query: url.Values{
+				"query":       []string{"0.333"},
+				"time":        []string{"1970-01-01T00:02:03Z"},
+				"consistency": []string{"nonexistent"},
+			},
+			errType: errorBadData,
+		},
 		// Bad query expression.
 		{
 			endpoint: api.query,
@@ -2114,10 +2158,132 @@ func (f *fakeDB) Stats(statsByLabelName string) (_ *tsdb.Stats, retErr error) {
 			retErr = err
 		}
 	}()
-	h, _ := tsdb.NewHead(nil, nil, nil, 1000, "", nil, tsdb.DefaultStripeSize, nil)
+	h, _ := tsdb.NewHead(nil, nil, nil, 1000, "", nil, tsdb.DefaultStripeSize, tsdb.DefaultHeadChunkWriteQueueSize, nil)
 	return h.Stats(statsByLabelName), nil
 }
 
+func TestScrapePoolPauseResumeEndpoints(t *testing.T) {
+	tr := newTestTargetRetriever([]*testTargetParams{{Identifier: "test", Labels: labels.FromStrings("job", "test"), Active: true}})
+	pauseAPI := func(api *API) apiFunc { return api.pauseScrapePool }
+	resumeAPI := func(api *API) apiFunc { return api.resumeScrapePool }
+
+	for _, tc := range []struct {
+		pool        string
+		enableAdmin bool
+		endpoint    func(api *API) apiFunc
+
+		errType errorType
+	}{
+		{pool: "test", enableAdmin: false, endpoint: pauseAPI, errType: errorUnavailable},
+		{pool: "test", enableAdmin: true, endpoint: pauseAPI, errType: errorNone},
+		{pool: "does-not-exist", enableAdmin: true, endpoint: pauseAPI, errType: errorNotFound},
+		{pool: "test", enableAdmin: false, endpoint: resumeAPI, errType: errorUnavailable},
+		{pool: "test", enableAdmin: true, endpoint: resumeAPI, errType: errorNone},
+		{pool: "does-not-exist", enableAdmin: true, endpoint: resumeAPI, errType: errorNotFound},
+	} {
+		tc := tc
+		t.Run("", func(t *testing.T) {
+			api := &API{
+				ready:           func(f http.HandlerFunc) http.HandlerFunc { return f },
+				enableAdmin:     tc.enableAdmin,
+				targetRetriever: func(context.Context) TargetRetriever { return tr },
+			}
+
+			endpoint := tc.endpoint(api)
+			req, err := http.NewRequest(http.MethodPost, "", nil)
+			testutil.Ok(t, err)
+			ctx := route.WithParam(req.Context(), "pool", tc.pool)
+
+			res := setUnavailStatusOnTSDBNotReady(endpoint(req.WithContext(ctx)))
+			assertAPIError(t, res.err, tc.errType)
+		})
+	}
+}
+
+func TestRuleTest(t *testing.T) {
+	suite, err := promql.NewTest(t, `
+		load 1m
+			test_metric1{foo="bar"} 0+100x100
+	`)
+	testutil.Ok(t, err)
+	defer suite.Close()
+	testutil.Ok(t, suite.Run())
+
+	var algr rulesRetrieverMock
+	algr.testing = t
+
+	api := &API{
+		Queryable:      suite.Storage(),
+		QueryEngine:    suite.QueryEngine(),
+		config:         func() config.Config { return samplePrometheusCfg },
+		ready:          func(f http.HandlerFunc) http.HandlerFunc { return f },
+		now:            func() time.Time { return time.Unix(0, 0) },
+		rulesRetriever: algr.toFactory(),
+	}
+
+	for _, tc := range []struct {
+		name     string
+		form     url.Values
+		errType  errorType
+		numAlert int
+	}{
+		{
+			name:     "inline expr that matches",
+			form:     url.Values{"expr": {`test_metric1{foo="bar"}`}},
+			errType:  errorNone,
+			numAlert: 1,
+		},
+		{
+			name:     "inline expr that matches nothing",
+			form:     url.Values{"expr": {`test_metric1{foo="nope"}`}},
+			errType:  errorNone,
+			numAlert: 0,
+		},
+		{
+			name:    "invalid expr",
+			form:    url.Values{"expr": {`this is not promql`}},
+			errType: errorBadData,
+		},
+		{
+			name:    "neither expr nor rule reference given",
+			form:    url.Values{},
+			errType: errorBadData,
+		},
+		{
+			name:     "existing rule referenced by group and name",
+			form:     url.Values{"group": {"grp"}, "rule": {"test_metric3"}},
+			errType:  errorNone,
+			numAlert: 0, // absent(test_metric3) != 1 is false since test_metric3 has no samples.
+		},
+		{
+			name:    "unknown rule group",
+			form:    url.Values{"group": {"does-not-exist"}, "rule": {"test_metric3"}},
+			errType: errorNotFound,
+		},
+		{
+			name:    "unknown rule name",
+			form:    url.Values{"group": {"grp"}, "rule": {"does-not-exist"}},
+			errType: errorNotFound,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, "", strings.NewReader(tc.form.Encode()))
+			testutil.Ok(t, err)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			res := api.ruleTest(req)
+			assertAPIError(t, res.err, tc.errType)
+			if tc.errType != errorNone {
+				return
+			}
+
+			result, ok := res.data.(ruleTestResult)
+			testutil.Assert(t, ok, "result is not a ruleTestResult")
+			testutil.Equals(t, tc.numAlert, len(result.Alerts))
+		})
+	}
+}
+
 func TestAdminEndpoints(t *testing.T) {
 	tsdb, tsdbWithError, tsdbNotReady := &fakeDB{}, &fakeDB{err: errors.New("some error")}, &fakeDB{err: errors.Wrap(tsdb.ErrNotReady, "wrap")}
 	snapshotAPI := func(api *API) apiFunc { return api.snapshot }
@@ -203,6 +203,21 @@ type Handler struct {
 	now func() model.Time
 
 	ready uint32 // ready is uint32 rather than boolean to be able to use atomic functions.
+
+	// readyDetail tracks the individual startup dependencies that /-/ready
+	// reports on, independently of the overall ready flag above, so that
+	// orchestrators can see which dependency is still pending.
+	readyDetail readinessDetail
+}
+
+// readinessDetail holds the individual startup dependencies reported by
+// /-/ready. Each field is 0/1 rather than bool so it can be read and written
+// with the atomic package, the same convention Handler.ready uses.
+type readinessDetail struct {
+	walReplayed     uint32
+	sdInitialized   uint32
+	rulesLoaded     uint32
+	remoteWriteConn uint32
 }
 
 // ApplyConfig updates the config field of the Handler struct
@@ -231,22 +246,26 @@ type Options struct {
 	Version               *PrometheusVersion
 	Flags                 map[string]string
 
-	ListenAddress              string
-	CORSOrigin                 *regexp.Regexp
-	ReadTimeout                time.Duration
-	MaxConnections             int
-	ExternalURL                *url.URL
-	RoutePrefix                string
-	UseLocalAssets             bool
-	UserAssetsPath             string
-	ConsoleTemplatesPath       string
-	ConsoleLibrariesPath       string
-	EnableLifecycle            bool
-	EnableAdminAPI             bool
-	PageTitle                  string
-	RemoteReadSampleLimit      int
-	RemoteReadConcurrencyLimit int
-	RemoteReadBytesInFrame     int
+	ListenAddress                string
+	CORSOrigin                   *regexp.Regexp
+	ReadTimeout                  time.Duration
+	MaxConnections               int
+	ExternalURL                  *url.URL
+	RoutePrefix                  string
+	UseLocalAssets               bool
+	UserAssetsPath               string
+	ConsoleTemplatesPath         string
+	ConsoleLibrariesPath         string
+	EnableLifecycle              bool
+	EnableAdminAPI               bool
+	PageTitle                    string
+	RemoteReadSampleLimit        int
+	RemoteReadConcurrencyLimit   int
+	RemoteReadBytesInFrame       int
+	RemoteReadMaxBytesInResponse int
+
+	APIResponseCompressionMinSize int
+	APIResponseCompressionLevel   int
 
 	Gatherer   prometheus.Gatherer
 	Registerer prometheus.Registerer
@@ -322,9 +341,11 @@ func New(logger log.Logger, o *Options) *Handler {
 		h.options.RemoteReadSampleLimit,
 		h.options.RemoteReadConcurrencyLimit,
 		h.options.RemoteReadBytesInFrame,
+		h.options.RemoteReadMaxBytesInResponse,
 		h.options.CORSOrigin,
 		h.runtimeInfo,
 		h.versionInfo,
+		o.ExternalURL,
 	)
 
 	if o.RoutePrefix != "/" {
@@ -443,10 +464,7 @@ func New(logger log.Logger, o *Options) *Handler {
 		w.WriteHeader(http.StatusOK)
 		fmt.Fprintf(w, "Prometheus is Healthy.\n")
 	})
-	router.Get("/-/ready", readyf(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprintf(w, "Prometheus is Ready.\n")
-	}))
+	router.Get("/-/ready", h.serveReady)
 
 	return h
 }
@@ -492,6 +510,62 @@ func (h *Handler) isReady() bool {
 	return ready > 0
 }
 
+// SetWALReplayed marks the WAL as having been replayed, one of the
+// dependencies reported by /-/ready.
+func (h *Handler) SetWALReplayed() {
+	atomic.StoreUint32(&h.readyDetail.walReplayed, 1)
+}
+
+// SetDiscoveryInitialized marks service discovery as having applied its
+// initial configuration, one of the dependencies reported by /-/ready.
+func (h *Handler) SetDiscoveryInitialized() {
+	atomic.StoreUint32(&h.readyDetail.sdInitialized, 1)
+}
+
+// SetRulesLoaded marks the initial rule groups as having been loaded, one of
+// the dependencies reported by /-/ready.
+func (h *Handler) SetRulesLoaded() {
+	atomic.StoreUint32(&h.readyDetail.rulesLoaded, 1)
+}
+
+// SetRemoteWriteConfigured marks remote write as having applied its initial
+// configuration, one of the dependencies reported by /-/ready.
+func (h *Handler) SetRemoteWriteConfigured() {
+	atomic.StoreUint32(&h.readyDetail.remoteWriteConn, 1)
+}
+
+// readinessStatus is the JSON body served by /-/ready, reporting both the
+// overall readiness already exposed via the HTTP status code and which of
+// the individual startup dependencies it is waiting on.
+type readinessStatus struct {
+	Status     string          `json:"status"`
+	Components map[string]bool `json:"components"`
+}
+
+// serveReady reports overall readiness via the HTTP status code, as before,
+// plus a breakdown of the individual startup dependencies it depends on so
+// that orchestrators can tell which one is still pending.
+func (h *Handler) serveReady(w http.ResponseWriter, r *http.Request) {
+	status := readinessStatus{
+		Components: map[string]bool{
+			"walReplayed":           atomic.LoadUint32(&h.readyDetail.walReplayed) > 0,
+			"discoveryInitialized":  atomic.LoadUint32(&h.readyDetail.sdInitialized) > 0,
+			"rulesLoaded":           atomic.LoadUint32(&h.readyDetail.rulesLoaded) > 0,
+			"remoteWriteConfigured": atomic.LoadUint32(&h.readyDetail.remoteWriteConn) > 0,
+		},
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if h.isReady() {
+		status.Status = "ready"
+		w.WriteHeader(http.StatusOK)
+	} else {
+		status.Status = "not ready"
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(&status)
+}
+
 // Checks if server is ready, calls f if it is, returns 503 if it is not.
 func (h *Handler) testReady(f http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -571,7 +645,11 @@ func (h *Handler) Run(ctx context.Context) error {
 		WithInstrumentation(setPathWithPrefix(apiPath + "/v1"))
 	h.apiV1.Register(av1)
 
-	mux.Handle(apiPath+"/v1/", http.StripPrefix(apiPath+"/v1", av1))
+	mux.Handle(apiPath+"/v1/", http.StripPrefix(apiPath+"/v1", httputil.CompressionHandler{
+		Handler: av1,
+		MinSize: h.options.APIResponseCompressionMinSize,
+		Level:   h.options.APIResponseCompressionLevel,
+	}))
 
 	mux.Handle(apiPath+"/", http.StripPrefix(apiPath,
 		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -15,6 +15,7 @@ package web
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -459,3 +460,45 @@ func TestHTTPMetrics(t *testing.T) {
 	testutil.Equals(t, 2, int(prom_testutil.ToFloat64(counter.WithLabelValues("/-/ready", strconv.Itoa(http.StatusOK)))))
 	testutil.Equals(t, 1, int(prom_testutil.ToFloat64(counter.WithLabelValues("/-/ready", strconv.Itoa(http.StatusServiceUnavailable)))))
 }
+
+func TestReadyComponents(t *testing.T) {
+	handler := New(nil, &Options{
+		RoutePrefix:   "/",
+		ListenAddress: "somehost:9090",
+		ExternalURL: &url.URL{
+			Host:   "localhost.localdomain:9090",
+			Scheme: "http",
+		},
+	})
+	getReady := func() (int, readinessStatus) {
+		t.Helper()
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest("GET", "/-/ready", nil)
+		testutil.Ok(t, err)
+
+		handler.router.ServeHTTP(w, req)
+
+		var status readinessStatus
+		testutil.Ok(t, json.NewDecoder(w.Body).Decode(&status))
+		return w.Code, status
+	}
+
+	code, status := getReady()
+	testutil.Equals(t, http.StatusServiceUnavailable, code)
+	testutil.Equals(t, "not ready", status.Status)
+	testutil.Assert(t, !status.Components["walReplayed"], "walReplayed should be false before it is set")
+
+	handler.SetWALReplayed()
+	handler.SetDiscoveryInitialized()
+	handler.SetRulesLoaded()
+	handler.SetRemoteWriteConfigured()
+	handler.Ready()
+
+	code, status = getReady()
+	testutil.Equals(t, http.StatusOK, code)
+	testutil.Equals(t, "ready", status.Status)
+	for _, c := range []string{"walReplayed", "discoveryInitialized", "rulesLoaded", "remoteWriteConfigured"} {
+		testutil.Assert(t, status.Components[c], "expected component %q to be true once ready", c)
+	}
+}